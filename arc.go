@@ -0,0 +1,337 @@
+package kad
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	ARC_FIT  = 0.01 // max distance in mm between a point and the arc it is matched to after clipping
+	ARC_CELL = 0.05 // size in mm of the cells the centers of the arcs are indexed by
+)
+
+// A single straight or arc segment of a contour, the segment starts at the end of the previous segment.
+type Segment struct {
+	End    Point   // end point of the segment
+	Center Point   // center of the arc
+	Radius float64 // radius of the arc, zero for a straight segment
+	Sweep  bool    // the arc goes in the positive angle direction (clockwise in SVG coordinates)
+}
+
+// A closed contour made up of straight and arc segments.
+type Contour struct {
+	Start    Point
+	Segments []Segment
+}
+
+// A circle which the points of a curve were placed on when it was drawn.
+type Arc struct {
+	Center Point
+	Radius float64
+	Step   float64 // max angle in radians between two points of the curve
+}
+
+// The arcs of the curves (round holes, fillets, corner reliefs, etc...) drawn on the layers, so they can
+// be output as true arcs once the clipper boolean operations have turned everything into polygons.
+// The methods draw the curves the same way as the polygon functions and keep track of their arcs.
+// A nil index draws the curves without keeping track of them.
+// Only circular curves are kept: circles, the corners of rounded rectangles, round offsets and fillets.
+// Superellipses, imported shapes and anything drawn with the plain polygon functions stay straight segments.
+// The arcs are not carried through clipping, the clipped segments are matched back to them with Find.
+type ArcIndex struct {
+	Arcs  []Arc
+	Kerf  float64 // the curves may be offset by the kerf in either direction
+	radii []float64
+	cells map[float64]map[[2]int64][]int
+}
+
+// Add an arc to the index, unless it is already there.
+func (ai *ArcIndex) Add(center Point, r, step float64) {
+	if ai == nil || r <= 0 {
+		return
+	}
+	if ai.cells == nil {
+		ai.cells = make(map[float64]map[[2]int64][]int)
+	}
+	key := math.Round(r*PRECISION) / PRECISION
+	if ai.cells[key] == nil {
+		ai.cells[key] = make(map[[2]int64][]int)
+		ai.radii = append(ai.radii, key)
+		sort.Float64s(ai.radii)
+	}
+	cell := [2]int64{int64(math.Floor(center.X / ARC_CELL)), int64(math.Floor(center.Y / ARC_CELL))}
+	for _, i := range ai.cells[key][cell] {
+		if a := ai.Arcs[i]; a.Center == center && a.Radius == r {
+			ai.Arcs[i].Step = math.Max(a.Step, step)
+			return
+		}
+	}
+	ai.cells[key][cell] = append(ai.cells[key][cell], len(ai.Arcs))
+	ai.Arcs = append(ai.Arcs, Arc{center, r, step})
+}
+
+// Add the arcs of another index to this one, with their centers moved by 'f'.
+func (ai *ArcIndex) Transform(from *ArcIndex, f func(Point) Point) {
+	if ai == nil || from == nil {
+		return
+	}
+	for _, a := range from.Arcs {
+		ai.Add(f(a.Center), a.Radius, a.Step)
+	}
+}
+
+// Move all of the arcs by 'r'.
+func (ai *ArcIndex) Rel(r Point) {
+	if ai == nil {
+		return
+	}
+	arcs := ai.Arcs
+	ai.Arcs, ai.radii, ai.cells = nil, nil, nil
+	for _, a := range arcs {
+		ai.Add(Point{a.Center.X + r.X, a.Center.Y + r.Y}, a.Radius, a.Step)
+	}
+}
+
+// Find the arc which the straight segment from 'a' to 'b' is a part of.
+// The segment matches when both ends are within ARC_FIT of the circle (with or without the kerf)
+// and it covers no more than one step of the curve, so a match never moves the outline by more than
+// ARC_FIT plus the facet error of the curve that was drawn.
+// The radius of the arc which is returned includes the kerf if the curve was offset by it.
+func (ai *ArcIndex) Find(a, b Point) (Arc, bool) {
+	if ai == nil || len(ai.Arcs) == 0 {
+		return Arc{}, false
+	}
+	d := math.Hypot(b.X-a.X, b.Y-a.Y)
+	if d == 0 {
+		return Arc{}, false
+	}
+	mid := Point{(a.X + b.X) / 2, (a.Y + b.Y) / 2}
+	normal := Point{-(b.Y - a.Y) / d, (b.X - a.X) / d}
+	offsets := []float64{0}
+	if ai.Kerf != 0 {
+		offsets = append(offsets, ai.Kerf, -ai.Kerf)
+	}
+	for _, key := range ai.radii {
+		cells := ai.cells[key]
+		for _, kerf := range offsets {
+			r := key + kerf
+			if r <= 0 || d > 2*r+ARC_FIT {
+				continue
+			}
+			// the center is on the bisector of the segment, on either side of it
+			h := math.Sqrt(math.Max(r*r-d*d/4, 0))
+			for _, side := range []float64{h, -h} {
+				c := Point{mid.X + side*normal.X, mid.Y + side*normal.Y}
+				cx, cy := int64(math.Floor(c.X/ARC_CELL)), int64(math.Floor(c.Y/ARC_CELL))
+				for x := cx - 1; x <= cx+1; x++ {
+					for y := cy - 1; y <= cy+1; y++ {
+						for _, i := range cells[[2]int64{x, y}] {
+							arc := ai.Arcs[i]
+							arc.Radius += kerf
+							if arc.Contains(a, b) {
+								return arc, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return Arc{}, false
+}
+
+// Check if the straight segment from 'a' to 'b' is one of the steps along the arc.
+func (arc Arc) Contains(a, b Point) bool {
+	ra := math.Hypot(a.X-arc.Center.X, a.Y-arc.Center.Y)
+	rb := math.Hypot(b.X-arc.Center.X, b.Y-arc.Center.Y)
+	if math.Abs(ra-arc.Radius) > ARC_FIT || math.Abs(rb-arc.Radius) > ARC_FIT {
+		return false
+	}
+	angle := math.Abs(math.Atan2(
+		(a.X-arc.Center.X)*(b.Y-arc.Center.Y)-(a.Y-arc.Center.Y)*(b.X-arc.Center.X),
+		(a.X-arc.Center.X)*(b.X-arc.Center.X)+(a.Y-arc.Center.Y)*(b.Y-arc.Center.Y)))
+	return angle <= arc.Step
+}
+
+// Draw a circle with each quarter made up of 's' segments.
+func (ai *ArcIndex) Circle(cx, cy, r float64, s int) Path {
+	ai.Add(Point{cx, cy}, r, radians(90/float64(s))*1.01)
+	return CirclePolygon(cx, cy, r, s)
+}
+
+// Draw a rectangle with optional rounded corners.
+// set 'r' and 's' to zero to have a non-rounded corner.
+func (ai *ArcIndex) RoundRectangle(cx, cy, w, h, r float64, s int) Path {
+	if r > 0 && s > 0 {
+		step := radians(90/float64(s)) * 1.01
+		for _, c := range []Point{{cx + w/2 - r, cy - h/2 + r}, {cx + w/2 - r, cy + h/2 - r},
+			{cx - w/2 + r, cy + h/2 - r}, {cx - w/2 + r, cy - h/2 + r}} {
+			ai.Add(c, r, step)
+		}
+	}
+	return RoundRectanglePolygon(cx, cy, w, h, r, s)
+}
+
+// Offset a set of closed paths by 'delta' with round corners, the corners are arcs around the original points.
+func (ai *ArcIndex) Round(paths []Path, delta float64) []Path {
+	if ai != nil && delta != 0 {
+		// the same steps clipper uses to draw the round joins (plus the last step, which can be longer)
+		tolerance := math.Min(ARC_TOLERANCE, math.Abs(delta)*0.25)
+		step := 2 * math.Acos(1-tolerance/math.Abs(delta)) * 1.5
+		for _, path := range paths {
+			for _, pt := range path {
+				ai.Add(pt, math.Abs(delta), step)
+			}
+		}
+	}
+	return OffsetPaths(paths, delta, clipper.JtRound)
+}
+
+// Round the corners of a set of closed paths.
+// 'convex' is the radius of the outside corners and 'concave' is the radius of the inside corners.
+func (ai *ArcIndex) Fillet(paths []Path, convex, concave float64) []Path {
+	// the first offset is mitered so the corners which are not rounded come back sharp
+	if concave > 0 { // grow then shrink to round the inside corners
+		paths = ai.Round(OffsetPaths(paths, concave, clipper.JtMiter), -concave)
+	}
+	if convex > 0 { // shrink then grow to round the outside corners
+		paths = ai.Round(OffsetPaths(paths, -convex, clipper.JtMiter), convex)
+	}
+	return paths
+}
+
+// Get the contour of the path, replacing the runs of points which are on one of the arcs with true arcs.
+// A lone segment which matches an arc stays straight, it is more likely a different edge which happens to fit.
+func (ps Path) Contour(arcs *ArcIndex) Contour {
+	n := len(ps)
+	if n < 3 || arcs == nil || len(arcs.Arcs) == 0 {
+		return ps.LineContour()
+	}
+	// the arc of each segment, from point 'i' to the next point
+	on := make([]Arc, n)
+	is := make([]bool, n)
+	for i := range ps {
+		on[i], is[i] = arcs.Find(ps[i], ps[(i+1)%n])
+	}
+	sweep := func(i int) bool {
+		a, b, c := ps[i%n], ps[(i+1)%n], on[i%n].Center
+		return (a.X-c.X)*(b.Y-c.Y)-(a.Y-c.Y)*(b.X-c.X) > 0
+	}
+	same := func(i, j int) bool {
+		i, j = i%n, j%n
+		return is[i] && is[j] && sweep(i) == sweep(j) &&
+			math.Hypot(on[i].Center.X-on[j].Center.X, on[i].Center.Y-on[j].Center.Y) <= ARC_FIT &&
+			math.Abs(on[i].Radius-on[j].Radius) <= ARC_FIT
+	}
+	lone := make([]bool, n)
+	for i := range ps {
+		lone[i] = is[i] && !same(i+n-1, i) && !same(i, i+1)
+	}
+	for i := range ps {
+		is[i] = is[i] && !lone[i]
+	}
+
+	// start the contour at a point which is not in the middle of an arc
+	start := -1
+	for i := 0; i < n; i++ {
+		if !same(i+n-1, i) {
+			start = i
+			break
+		}
+	}
+	if start == -1 { // every segment is on the same arc, so it is a full circle
+		a, c := ps[0], on[0]
+		b := Point{2*c.Center.X - a.X, 2*c.Center.Y - a.Y}
+		return Contour{Start: a, Segments: []Segment{{b, c.Center, c.Radius, sweep(0)}, {a, c.Center, c.Radius, sweep(0)}}}
+	}
+
+	contour := Contour{Start: ps[start]}
+	for i := 0; i < n; {
+		j := i + 1
+		if !is[(start+i)%n] {
+			contour.Segments = append(contour.Segments, Segment{End: ps[(start+j)%n]})
+			i = j
+			continue
+		}
+		for j < n && same(start+i, start+j) {
+			j++
+		}
+		arc := on[(start+i)%n]
+		contour.AddArc(ps[(start+j)%n], arc.Center, arc.Radius, sweep(start+i))
+		i = j
+	}
+	return contour
+}
+
+// Get the contour of the path using only straight segments.
+func (ps Path) LineContour() Contour {
+	contour := Contour{}
+	if len(ps) == 0 {
+		return contour
+	}
+	contour.Start = ps[0]
+	for i := 1; i <= len(ps); i++ {
+		contour.Segments = append(contour.Segments, Segment{End: ps[i%len(ps)]})
+	}
+	return contour
+}
+
+// Add an arc to the contour, splitting it so no single arc is more than a half circle.
+func (c *Contour) AddArc(end, center Point, r float64, sweep bool) {
+	start := c.End()
+	a := math.Atan2(start.Y-center.Y, start.X-center.X)
+	angle := SweepAngle(start, end, center, sweep)
+	if angle > math.Pi {
+		if !sweep {
+			a -= angle / 2
+		} else {
+			a += angle / 2
+		}
+		mid := Point{center.X + r*math.Cos(a), center.Y + r*math.Sin(a)}
+		c.Segments = append(c.Segments, Segment{mid, center, r, sweep})
+	}
+	c.Segments = append(c.Segments, Segment{end, center, r, sweep})
+}
+
+// Get the current end point of the contour.
+func (c Contour) End() Point {
+	if len(c.Segments) == 0 {
+		return c.Start
+	}
+	return c.Segments[len(c.Segments)-1].End
+}
+
+// Get the angle (in radians) covered by an arc from 'start' to 'end' around 'center'.
+func SweepAngle(start, end, center Point, sweep bool) float64 {
+	a := math.Atan2(start.Y-center.Y, start.X-center.X)
+	b := math.Atan2(end.Y-center.Y, end.X-center.X)
+	angle := b - a
+	if !sweep {
+		angle = -angle
+	}
+	for angle <= 0 {
+		angle += 2 * math.Pi
+	}
+	return angle
+}
+
+// Get the SVG path data for the contour.
+func (c Contour) SvgPath() string {
+	d := []string{fmt.Sprintf("M%.3f,%.3f", c.Start.X, c.Start.Y)}
+	for _, s := range c.Segments {
+		if s.Radius == 0 {
+			d = append(d, fmt.Sprintf("L%.3f,%.3f", s.End.X, s.End.Y))
+		} else {
+			sweep := 0
+			if s.Sweep {
+				sweep = 1
+			}
+			d = append(d, fmt.Sprintf("A%.3f,%.3f 0 0,%d %.3f,%.3f", s.Radius, s.Radius, sweep, s.End.X, s.End.Y))
+		}
+	}
+	return strings.Join(d, " ") + " Z"
+}
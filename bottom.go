@@ -0,0 +1,126 @@
+package kad
+
+import (
+	"log"
+	"math"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	FEATURE_FOOT        = "foot"
+	FEATURE_TENT        = "tent"
+	FEATURE_KICKSTAND   = "kickstand"
+	REL_CENTER          = "center"
+	REL_CORNERS         = "corners"
+	FOOT_DIAMETER       = 10.0 // typical rubber bumper
+	FOOT_MARKER         = 1.0  // small pilot hole to mark the position of a foot
+	TENT_HOLE_DIAMETER  = 5.2  // clearance for an M5 screw
+	TENT_HOLE_SPACING   = 20.0 // square spacing of the tenting puck holes
+	KICKSTAND_DIAMETER  = 6.0  // typical disc magnet
+	FOOT_CORNER_OFFSET  = "[12,12]"
+	KICKSTAND_POSITIONS = "[-x/2,-y+12];[x/2,-y+12]"
+)
+
+type BottomFeature struct {
+	Type     string  `json:"type"`     // foot, tent or kickstand
+	RelTo    string  `json:"rel_to"`   // position relative to the 'center' or the 'corners' of the case
+	Points   string  `json:"points"`   // offset from the center, or inward from each of the corners
+	Diameter float64 `json:"diameter"` // diameter of the hole cut through the bottom layer
+	Spacing  float64 `json:"spacing"`  // spacing of the tenting puck holes
+	Marker   bool    `json:"marker"`   // only cut a pilot hole to mark the position of the foot
+}
+
+// Cut the holes for the rubber feet, tenting puck and kickstand features through the bottom layer.
+// The feet sit in their holes, so the bumpers have to be taller than the bottom layer is thick.
+func (k *KAD) DrawBottomFeatures() {
+	if len(k.Case.BottomFeatures) == 0 {
+		return
+	}
+	if !in_strings(BOTTOMLAYER, k.Result.Plates) {
+		log.Printf("ERROR: bottom features require a bottom layer: %s", k.Hash)
+		return
+	}
+	for _, bf := range k.Case.BottomFeatures {
+		// set the defaults for each type of feature
+		var points, rel_to string
+		var diameter float64
+		switch bf.Type {
+		case FEATURE_FOOT:
+			points, rel_to, diameter = FOOT_CORNER_OFFSET, REL_CORNERS, FOOT_DIAMETER
+			if bf.Marker {
+				diameter = FOOT_MARKER
+			}
+		case FEATURE_TENT:
+			points, rel_to, diameter = "[0,0]", REL_CENTER, TENT_HOLE_DIAMETER
+		case FEATURE_KICKSTAND:
+			points, rel_to, diameter = KICKSTAND_POSITIONS, REL_CENTER, KICKSTAND_DIAMETER
+		default:
+			log.Printf("ERROR: unknown bottom feature '%s' for: %s", bf.Type, k.Hash)
+			continue
+		}
+		if bf.Points != "" {
+			points = bf.Points
+		}
+		if bf.RelTo != "" {
+			rel_to = bf.RelTo
+		}
+		if bf.Diameter > 0 && !(bf.Type == FEATURE_FOOT && bf.Marker) {
+			diameter = bf.Diameter
+		}
+
+		// get the center of each feature
+		centers := make(Path, 0)
+		for _, path := range k.ParsePoints(points, "[0,0]", rel_to != REL_CORNERS) {
+			if rel_to != REL_CORNERS {
+				centers = append(centers, path...)
+				continue
+			}
+			for _, pt := range path {
+				centers = append(centers, k.OutlineCorners(pt)...)
+			}
+		}
+
+		// a tenting puck is mounted with a square of holes around each center
+		if bf.Type == FEATURE_TENT {
+			spacing := TENT_HOLE_SPACING
+			if bf.Spacing > 0 {
+				spacing = bf.Spacing
+			}
+			holes := make(Path, 0)
+			for _, c := range centers {
+				holes = append(holes, Path{
+					{c.X - spacing/2, c.Y - spacing/2}, {c.X + spacing/2, c.Y - spacing/2},
+					{c.X + spacing/2, c.Y + spacing/2}, {c.X - spacing/2, c.Y + spacing/2}}...)
+			}
+			centers = holes
+		}
+
+		for _, c := range centers {
+			k.Layers[BOTTOMLAYER].CutPolys = append(k.Layers[BOTTOMLAYER].CutPolys,
+				k.Arcs.Circle(c.X, c.Y, diameter/2, 5))
+		}
+	}
+}
+
+// Get the offset 'pt' mirrored in from each corner of the bounds of the case outline.
+// A point which is not inside the outline (such as beside a staggered row) is moved to
+// the nearest point which is as far in from the outline as the smaller of the offsets.
+func (k *KAD) OutlineCorners(pt Point) Path {
+	outline := k.CaseOutline(nil)
+	pts := make(Path, 0)
+	for _, path := range outline {
+		pts = append(pts, path...)
+	}
+	b := pts.Bounds()
+	inset := OffsetPaths(outline, -math.Max(math.Min(pt.X, pt.Y), 0), clipper.JtMiter)
+	corners := Path{
+		{b.Xmin + pt.X, b.Ymin + pt.Y}, {b.Xmax - pt.X, b.Ymin + pt.Y},
+		{b.Xmax - pt.X, b.Ymax - pt.Y}, {b.Xmin + pt.X, b.Ymax - pt.Y}}
+	for i, c := range corners {
+		if len(inset) > 0 && !PointInPaths(c, inset) {
+			corners[i] = NearestPoint(inset, c)
+		}
+	}
+	return corners
+}
@@ -1,6 +1,9 @@
 package kad
 
-import "math"
+import (
+	"log"
+	"math"
+)
 
 const (
 	CASE_NONE        = ""
@@ -16,6 +19,15 @@ const (
 	BOTTOMLAYER_NAME = "Bottom Layer"
 	CLOSEDLAYER_NAME = "Closed Layer"
 	OPENLAYER_NAME   = "Open Layer"
+	OUTLINE_RECT     = "rectangle"
+	OUTLINE_LAYOUT   = "layout"
+	OUTLINE_IMPORT   = "import"
+	EDGE_TOP         = "top"
+	EDGE_BOTTOM      = "bottom"
+	EDGE_LEFT        = "left"
+	EDGE_RIGHT       = "right"
+	HOLES_EDGE       = "edge"
+	HOLES_TRAY       = "tray"
 )
 
 type Case struct {
@@ -29,9 +41,23 @@ type Case struct {
 	BottomWidth      float64
 	Xholes           int
 	Yholes           int
-	RemovePokerSlots bool    `json:"poker-slots-remove"`
-	UsbLocation      float64 `json:"usb-location"`
-	UsbWidth         float64 `json:"usb-width"`
+	HolePlacement    string          `json:"mount-holes-placement"`
+	HoleClearance    float64         `json:"mount-holes-clearance"`
+	HolePoints       string          `json:"mount-holes-points"`
+	RemovePokerSlots bool            `json:"poker-slots-remove"`
+	UsbLocation      float64         `json:"usb-location"`
+	UsbWidth         float64         `json:"usb-width"`
+	Connectors       []Connector     `json:"connectors"`
+	BottomFeatures   []BottomFeature `json:"bottom-features"`
+}
+
+type Connector struct {
+	Edge   string   `json:"edge"`   // edge of the case to cut through: top, bottom, left or right
+	Offset float64  `json:"offset"` // offset along the edge from the center of the layout
+	Width  float64  `json:"width"`  // width of the opening along the edge
+	Depth  float64  `json:"depth"`  // depth of the opening from the edge, defaults to the padding on that edge
+	Radius float64  `json:"radius"` // corner radius of the opening
+	Layers []string `json:"layers"` // layers to cut, defaults to the open layer
 }
 
 func (k *KAD) InitCaseLayers() {
@@ -72,6 +98,21 @@ func (k *KAD) InitCaseLayers() {
 }
 
 func (k *KAD) InitCaseEdges() {
+	// fall back to the rectangle around the layout if the outline can not be drawn
+	switch k.Outline {
+	case OUTLINE_RECT, OUTLINE_LAYOUT:
+	case OUTLINE_IMPORT:
+		if !k.OutlineImport.Enabled() {
+			log.Printf("ERROR the 'import' outline has no drawing: %s", k.Hash)
+			k.Outline = OUTLINE_RECT
+		}
+	case "":
+		k.Outline = OUTLINE_RECT
+	default:
+		log.Printf("ERROR unknown case outline: %s", k.Outline)
+		k.Outline = OUTLINE_RECT
+	}
+
 	// update the case edge width details
 	k.Case.LeftWidth, k.Case.RightWidth, k.Case.TopWidth, k.Case.BottomWidth = k.LeftPad, k.RightPad, k.TopPad, k.BottomPad
 	if k.LeftPad > k.Case.EdgeWidth && k.Case.EdgeWidth != 0 {
@@ -88,41 +129,291 @@ func (k *KAD) InitCaseEdges() {
 	}
 }
 
+// Get the outer 'keep' boundary of the case.
+// The default is a rectangle around the layout, while the 'layout' outline hugs the keys
+// and the 'import' outline is a drawing centered on the layout.
+// The arcs of the corners are added to 'arcs' if it is not nil.
+func (k *KAD) CaseOutline(arcs *ArcIndex) []Path {
+	switch {
+	case k.Outline == OUTLINE_LAYOUT:
+		outline := k.LayoutOutline(k.LeftPad, k.RightPad, k.TopPad, k.BottomPad)
+		return arcs.Fillet(outline, k.Fillet, k.Fillet)
+	case k.Outline == OUTLINE_IMPORT:
+		return arcs.Fillet(k.ImportOutline(), k.Fillet, k.Fillet)
+	default:
+		corner_segments := 20
+		if k.Fillet == 0 {
+			corner_segments = 0 // square corner
+		}
+		return []Path{arcs.RoundRectangle(k.DMZ+(k.Width/2), k.DMZ+(k.Height/2),
+			k.Width, k.Height, k.Fillet, corner_segments)}
+	}
+}
+
+// Get the open area inside the case edge which is cut out of the middle layers.
+// The arcs of the corners are added to 'arcs' if it is not nil.
+func (k *KAD) CaseInterior(arcs *ArcIndex) []Path {
+	// keep the case edge a consistent width around the filleted corners
+	edge := math.Min(math.Min(k.Case.LeftWidth, k.Case.RightWidth), math.Min(k.Case.TopWidth, k.Case.BottomWidth))
+	switch {
+	case k.Outline == OUTLINE_LAYOUT:
+		interior := k.LayoutOutline(k.LeftPad-k.Case.LeftWidth, k.RightPad-k.Case.RightWidth,
+			k.TopPad-k.Case.TopWidth, k.BottomPad-k.Case.BottomWidth)
+		return arcs.Fillet(interior, math.Max(k.Fillet-edge, 0), k.Fillet+edge)
+	case k.Outline == OUTLINE_IMPORT:
+		// the drawing has no padding on each side, so the edge is the same width all the way around
+		return arcs.Round(k.CaseOutline(arcs), -edge)
+	default:
+		mid_pts := Path{
+			{-k.Width/2 + k.Case.LeftWidth, -k.Height/2 + k.Case.TopWidth},
+			{k.Width/2 - k.Case.RightWidth, -k.Height/2 + k.Case.TopWidth},
+			{k.Width/2 - k.Case.RightWidth, k.Height/2 - k.Case.BottomWidth},
+			{-k.Width/2 + k.Case.LeftWidth, k.Height/2 - k.Case.BottomWidth}}
+		mid_pts.Rel(k.CaseCenter)
+		return []Path{mid_pts}
+	}
+}
+
+// Get the outline of the layout by growing the bounds of each key by the padding on each side.
+func (k *KAD) LayoutOutline(left, right, top, bottom float64) []Path {
+	pad := Path{
+		{-math.Max(left, 0), -math.Max(top, 0)}, {math.Max(right, 0), -math.Max(top, 0)},
+		{math.Max(right, 0), math.Max(bottom, 0)}, {-math.Max(left, 0), math.Max(bottom, 0)}}
+	grown := make([]Path, 0)
+	for _, row := range k.Layout {
+		for _, key := range row {
+			pts := make(Path, 0)
+			for _, b := range key.Bounds {
+				for _, p := range pad {
+					pts = append(pts, Point{b.X + p.X, b.Y + p.Y})
+				}
+			}
+			grown = append(grown, ConvexHull(pts))
+		}
+	}
+	outline, ok := UnionPaths(grown)
+	if !ok {
+		log.Printf("ERROR creating the layout outline: %s", k.Hash)
+	}
+	return outline
+}
+
 // Draw the holes for the KAD based on the type of case selected.
 func (k *KAD) DrawHoles() {
+	if k.Case.HolePoints != "" {
+		k.DrawPlacedHoles()
+		return
+	}
+	switch k.Case.HolePlacement {
+	case HOLES_EDGE, HOLES_TRAY:
+		k.DrawPlacedHoles()
+		return
+	case "":
+	default:
+		log.Printf("ERROR: unknown mount hole placement '%s' for: %s, using the holes of the case type",
+			k.Case.HolePlacement, k.Hash)
+	}
 	switch k.Case.Type {
 	case CASE_POKER:
 		points := k.GetPokerHoles()
+		k.CheckHoles(points)
 		for i := range points {
 			// create circle polygons with 5 segments per 1/4 turn
 			k.Layers[SWITCHLAYER].CutPolys = append(k.Layers[SWITCHLAYER].CutPolys,
-				CirclePolygon(points[i].X, points[i].Y, (k.Case.HoleDiameter/2)-k.Kerf, 5))
+				k.Arcs.Circle(points[i].X, points[i].Y, k.Case.HoleDiameter/2, 5))
 		}
 		if !k.Case.RemovePokerSlots {
 			// calculate polygon slots for poker layer
-			depth := 6.0 - k.Kerf                             // total depth of the side slots in mm
+			depth := 6.0                                      // total depth of the side slots in mm
 			sc := k.Width/2 - (depth-k.Case.HoleDiameter/2)/2 // round rectangle center for slot
 			slots := Path{{sc, 9.2}, {-sc, 9.2}}
 			slots.Rel(k.CaseCenter) // make relative to the actual cad coords
 			for _, center := range slots {
-				slot := RoundRectanglePolygon(center.X, center.Y,
-					depth+k.Case.HoleDiameter/2, k.Case.HoleDiameter-k.Kerf*2,
-					k.Case.HoleDiameter/2-k.Kerf-.001, 5)
+				slot := k.Arcs.RoundRectangle(center.X, center.Y,
+					depth+k.Case.HoleDiameter/2, k.Case.HoleDiameter,
+					k.Case.HoleDiameter/2-.001, 5)
 				k.Layers[SWITCHLAYER].CutPolys = append(k.Layers[SWITCHLAYER].CutPolys, slot)
 			}
 		}
 	case CASE_SANDWICH:
 		points := k.GetSandwichHoles()
+		k.CheckHoles(points)
 		for _, layer := range k.Result.Plates {
 			for i := range points {
 				// create circle polygons with 5 segments per 1/4 turn
 				k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys,
-					CirclePolygon(points[i].X, points[i].Y, (k.Case.HoleDiameter/2)-k.Kerf, 5))
+					k.Arcs.Circle(points[i].X, points[i].Y, k.Case.HoleDiameter/2, 5))
+			}
+		}
+	}
+}
+
+// Draw the connector openings (usb, trrs, cable channels, etc...) through the edges of the case.
+// The openings are measured from where the case outline crosses the edge, so they follow any outline.
+func (k *KAD) DrawConnectors() {
+	if len(k.Case.Connectors) == 0 {
+		k.DrawUsbOpening()
+		return
+	}
+	outline := k.CaseOutline(nil)
+	interior := Path{}
+	for _, path := range k.CaseInterior(nil) {
+		interior = append(interior, path...)
+	}
+	ib := interior.Bounds()
+	for _, con := range k.Case.Connectors {
+		if con.Width <= 0 {
+			continue
+		}
+		layers := con.Layers
+		if len(layers) == 0 {
+			layers = []string{OPENLAYER}
+		}
+		radius := math.Min(con.Radius, con.Width/2-.001)
+		if radius < 0 {
+			radius = 0
+		}
+
+		// get the span of the edge and the padding on that side
+		var pad, start, end, center float64
+		switch con.Edge {
+		case EDGE_TOP, EDGE_BOTTOM:
+			pad = k.TopPad
+			if con.Edge == EDGE_BOTTOM {
+				pad = k.BottomPad
+			}
+			start, end, center = ib.Xmin, ib.Xmax, k.LayoutCenter.X
+		case EDGE_LEFT, EDGE_RIGHT:
+			pad = k.LeftPad
+			if con.Edge == EDGE_RIGHT {
+				pad = k.RightPad
+			}
+			start, end, center = ib.Ymin, ib.Ymax, k.LayoutCenter.Y
+		default:
+			log.Printf("ERROR: unknown connector edge '%s' for: %s", con.Edge, k.Hash)
+			continue
+		}
+		depth := con.Depth
+		if depth <= 0 {
+			depth = pad // cut through to the inside of the case
+		}
+
+		// keep the opening inside the span of the edge
+		pos := center + con.Offset
+		if pos < start+con.Width/2 {
+			pos = start + con.Width/2
+		}
+		if pos > end-con.Width/2 {
+			pos = end - con.Width/2
+		}
+		if end-start < con.Width {
+			pos = (start + end) / 2
+		}
+
+		// overlap the edge so it is completely removed (including the rounded corners)
+		outer, inner, ok := EdgeCrossing(outline, con.Edge, pos-con.Width/2, pos+con.Width/2)
+		if !ok {
+			log.Printf("ERROR: connector on the '%s' edge does not cross the case for: %s", con.Edge, k.Hash)
+			continue
+		}
+		from, to := outer-1-radius, inner+depth
+		mid, length := (from+to)/2, to-from
+		var slot Path
+		switch con.Edge {
+		case EDGE_TOP:
+			slot = k.Arcs.RoundRectangle(pos, mid, con.Width, length, radius, 5)
+		case EDGE_BOTTOM:
+			slot = k.Arcs.RoundRectangle(pos, -mid, con.Width, length, radius, 5)
+		case EDGE_LEFT:
+			slot = k.Arcs.RoundRectangle(mid, pos, length, con.Width, radius, 5)
+		case EDGE_RIGHT:
+			slot = k.Arcs.RoundRectangle(-mid, pos, length, con.Width, radius, 5)
+		}
+		for _, layer := range layers {
+			if in_strings(layer, k.Result.Plates) {
+				k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys, slot.Copy())
+				k.Layers[layer].EdgeCuts = append(k.Layers[layer].EdgeCuts, slot.Copy())
 			}
 		}
 	}
 }
 
+// Draw the usb opening through the top edge of the open layer, which is replaced by the connectors when they are used.
+func (k *KAD) DrawUsbOpening() {
+	if k.Case.UsbWidth <= 0 || !in_strings(OPENLAYER, k.Result.Plates) {
+		return
+	}
+	layer := OPENLAYER
+	usb_shift := k.Case.UsbLocation
+	if usb_shift < -(k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2) {
+		usb_shift = -(k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2)
+	}
+	if usb_shift > (k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2) {
+		usb_shift = k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2
+	}
+	usb_width := k.Case.UsbWidth
+	if usb_width > (k.Width - 2*k.Case.EdgeWidth) {
+		usb_width = k.Width - 2*k.Case.EdgeWidth
+		usb_shift = 0
+	}
+	c := Point{k.LayoutCenter.X + usb_shift, k.DMZ + k.TopPad/2}
+	usb_pts := Path{
+		{-usb_width / 2, -k.TopPad / 2}, {usb_width / 2, -k.TopPad / 2},
+		{usb_width / 2, k.TopPad / 2}, {-usb_width / 2, k.TopPad / 2}}
+	usb_pts.Rel(c)
+	// overlap top side so it is completely removed...
+	usb_pts[0].Y -= 1
+	usb_pts[1].Y -= 1
+	k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys, usb_pts)
+	k.Layers[layer].EdgeCuts = append(k.Layers[layer].EdgeCuts, usb_pts)
+}
+
+// Get where the outline is first crossed coming in from an edge, over the span 'from' to 'to' along the edge.
+// The crossing is measured inwards from the edge, so it is negated for the bottom and right edges,
+// and both the outermost and innermost crossing over the span are returned.
+func EdgeCrossing(outline []Path, edge string, from, to float64) (float64, float64, bool) {
+	// turn the points so the edge is at the top, with 'u' along the edge and 'v' pointing into the case
+	uv := func(p Point) (float64, float64) {
+		switch edge {
+		case EDGE_BOTTOM:
+			return p.X, -p.Y
+		case EDGE_LEFT:
+			return p.Y, p.X
+		case EDGE_RIGHT:
+			return p.Y, -p.X
+		default:
+			return p.X, p.Y
+		}
+	}
+	// the crossing only changes direction at the ends of the span and at the corners of the outline
+	samples := []float64{from, (from + to) / 2, to}
+	for _, path := range outline {
+		for _, p := range path {
+			if u, _ := uv(p); u > from && u < to {
+				samples = append(samples, u)
+			}
+		}
+	}
+	outer, inner, found := math.Inf(1), math.Inf(-1), false
+	for _, u := range samples {
+		first := math.Inf(1)
+		for _, path := range outline {
+			for i := range path {
+				au, av := uv(path[i])
+				bu, bv := uv(path[(i+1)%len(path)])
+				if au == bu || u < math.Min(au, bu) || u > math.Max(au, bu) {
+					continue
+				}
+				first = math.Min(first, av+(bv-av)*(u-au)/(bu-au))
+			}
+		}
+		if !math.IsInf(first, 1) {
+			outer, inner, found = math.Min(outer, first), math.Max(inner, first), true
+		}
+	}
+	return outer, inner, found
+}
+
 // Get the Path for a Poker case hole placement.
 func (k *KAD) GetPokerHoles() Path {
 	// the slots at {139, 9.2}, {-139, 9.2} are handled by the 'DrawHoles' function
@@ -140,8 +431,8 @@ func (k *KAD) GetSandwichHoles() Path {
 			k.Case.LeftWidth == k.Case.EdgeWidth && k.Case.RightWidth == k.Case.EdgeWidth:
 
 			var x_len, y_len float64
-			x_len = k.Width - k.Case.EdgeWidth - k.Kerf  // x length to split
-			y_len = k.Height - k.Case.EdgeWidth - k.Kerf // y length to split
+			x_len = k.Width - k.Case.EdgeWidth  // x length to split
+			y_len = k.Height - k.Case.EdgeWidth // y length to split
 			x_num := 0.0
 			y_num := 0.0
 			for i := 0.0; i < (float64(k.Case.Holes-4) / 2); i++ {
@@ -159,10 +450,10 @@ func (k *KAD) GetSandwichHoles() Path {
 				}
 			}
 			// the hole layout has been determined
-			x_gap := (x_len - k.Kerf) / (x_num + 1)
-			y_gap := (y_len - k.Kerf) / (y_num + 1)
+			x_gap := x_len / (x_num + 1)
+			y_gap := y_len / (y_num + 1)
 			// start layout out the points
-			p := &Point{X: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf, Y: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf} // start at top left  // LeftPad, TopPad
+			p := &Point{X: k.DMZ + k.Case.EdgeWidth/2, Y: k.DMZ + k.Case.EdgeWidth/2} // start at top left  // LeftPad, TopPad
 			for i := 0.0; i < x_num+1; i++ {
 				p.X += x_gap
 				points = append(points, Point{p.X, p.Y})
@@ -181,19 +472,19 @@ func (k *KAD) GetSandwichHoles() Path {
 			}
 		case k.Case.TopWidth == k.Case.EdgeWidth && k.Case.BottomWidth == k.Case.EdgeWidth:
 			var x_len, x_num float64
-			x_len = k.Width - k.Case.EdgeWidth - k.Kerf // x length to split
+			x_len = k.Width - k.Case.EdgeWidth // x length to split
 			x_num = float64(k.Case.Holes-4) / 2
 
 			// the hole layout has been determined
-			x_gap := (x_len - k.Kerf) / (x_num + 1)
+			x_gap := x_len / (x_num + 1)
 			// start layout out the points
-			p := &Point{X: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf, Y: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf} // start at top left
+			p := &Point{X: k.DMZ + k.Case.EdgeWidth/2, Y: k.DMZ + k.Case.EdgeWidth/2} // start at top left
 			points = append(points, Point{p.X, p.Y})
 			for i := 0.0; i < x_num+1; i++ {
 				p.X += x_gap
 				points = append(points, Point{p.X, p.Y})
 			}
-			p = &Point{X: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf, Y: k.DMZ + k.Height - k.Case.EdgeWidth/2 - k.Kerf} // start at bottom left
+			p = &Point{X: k.DMZ + k.Case.EdgeWidth/2, Y: k.DMZ + k.Height - k.Case.EdgeWidth/2} // start at bottom left
 			points = append(points, Point{p.X, p.Y})
 			for i := 0.0; i < x_num+1; i++ {
 				p.X += x_gap
@@ -201,19 +492,19 @@ func (k *KAD) GetSandwichHoles() Path {
 			}
 		case k.Case.LeftWidth == k.Case.EdgeWidth && k.Case.RightWidth == k.Case.EdgeWidth:
 			var y_len, y_num float64
-			y_len = k.Height - k.Case.EdgeWidth - k.Kerf // y length to split
+			y_len = k.Height - k.Case.EdgeWidth // y length to split
 			y_num = float64(k.Case.Holes-4) / 2
 
 			// the hole layout has been determined
-			y_gap := (y_len - k.Kerf) / (y_num + 1)
+			y_gap := y_len / (y_num + 1)
 			// start layout out the points
-			p := &Point{X: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf, Y: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf} // start at top left
+			p := &Point{X: k.DMZ + k.Case.EdgeWidth/2, Y: k.DMZ + k.Case.EdgeWidth/2} // start at top left
 			points = append(points, Point{p.X, p.Y})
 			for i := 0.0; i < y_num+2; i++ {
 				p.Y += y_gap
 				points = append(points, Point{p.X, p.Y})
 			}
-			p = &Point{X: k.DMZ + k.Width - k.Case.EdgeWidth/2 - k.Kerf, Y: k.DMZ + k.Case.EdgeWidth/2 + k.Kerf} // start at top right
+			p = &Point{X: k.DMZ + k.Width - k.Case.EdgeWidth/2, Y: k.DMZ + k.Case.EdgeWidth/2} // start at top right
 			points = append(points, Point{p.X, p.Y})
 			for i := 0.0; i < y_num+2; i++ {
 				p.Y += y_gap
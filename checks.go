@@ -0,0 +1,105 @@
+package kad
+
+import (
+	"fmt"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	CHECK_KEY_OVERLAP   = "key-overlap"
+	CHECK_KEY_EDGE      = "key-case-edge"
+	CHECK_OUT_OF_BOUNDS = "cut-out-of-bounds"
+	CHECK_TOUCH         = 0.01 // distance in mm at which a cut is considered to touch an edge
+)
+
+// Check the switch and stabilizer openings of the keys for overlaps with each other and with the case edge.
+// Keys are referenced by their index in the layout, counting across the rows.
+// A stacked key and the key placed on top of it (the next key in the row) are alternatives, so they are expected to overlap.
+func (k *KAD) CheckKeys() {
+	keys := make([]Key, 0)
+	rows := make([]int, 0)
+	for r, row := range k.Layout {
+		keys = append(keys, row...)
+		for range row {
+			rows = append(rows, r)
+		}
+	}
+	bounds := make([]Bounds, len(keys))
+	for i, key := range keys {
+		pts := make(Path, 0)
+		for _, path := range key.Cutouts {
+			pts = append(pts, path...)
+		}
+		bounds[i] = pts.Bounds()
+	}
+
+	for i := range keys {
+		for j := i + 1; j < len(keys); j++ {
+			a, b := bounds[i], bounds[j]
+			stacked := keys[i].Stacked && j == i+1 && rows[i] == rows[j]
+			if len(keys[i].Cutouts) == 0 || len(keys[j].Cutouts) == 0 || stacked ||
+				a.Xmax < b.Xmin || b.Xmax < a.Xmin || a.Ymax < b.Ymin || b.Ymax < a.Ymin {
+				continue
+			}
+			if overlap := IntersectPaths(keys[i].Cutouts, keys[j].Cutouts); len(overlap) > 0 {
+				k.Result.Warnings = append(k.Result.Warnings, Warning{
+					Code:     CHECK_KEY_OVERLAP,
+					Layer:    SWITCHLAYER,
+					Message:  fmt.Sprintf("The openings of key %d and key %d overlap.", i, j),
+					Location: PathsCenter(overlap),
+					Keys:     []int{i, j},
+				})
+			}
+		}
+	}
+
+	// the openings have to stay inside the case edge of the middle layers
+	if in_strings(OPENLAYER, k.Result.Plates) || in_strings(CLOSEDLAYER, k.Result.Plates) {
+		interior := k.CaseInterior(nil)
+		for i, key := range keys {
+			if len(key.Cutouts) == 0 {
+				continue
+			}
+			outside, ok := DifferencePaths(OffsetPaths(key.Cutouts, CHECK_TOUCH, clipper.JtMiter), interior)
+			if ok && len(outside) > 0 {
+				k.Result.Warnings = append(k.Result.Warnings, Warning{
+					Code:     CHECK_KEY_EDGE,
+					Layer:    SWITCHLAYER,
+					Message:  fmt.Sprintf("The openings of key %d touch or cross the case edge.", i),
+					Location: PathsCenter(outside),
+					Keys:     []int{i},
+				})
+			}
+		}
+	}
+}
+
+// Check if any of the cuts of a layer touch or cross the outline of the layer.
+func (k *KAD) CheckCutBounds(layer string) {
+	l := k.Layers[layer]
+	for _, cut := range l.CutPolys {
+		if PathsIntersect([]Path{cut}, l.EdgeCuts) { // meant to open up the edge
+			continue
+		}
+		outside, ok := DifferencePaths(OffsetPaths([]Path{cut}, CHECK_TOUCH, clipper.JtMiter), l.KeepPolys)
+		if ok && len(outside) > 0 {
+			k.Result.Warnings = append(k.Result.Warnings, Warning{
+				Code:     CHECK_OUT_OF_BOUNDS,
+				Layer:    layer,
+				Message:  "A cut touches or crosses the outline of the layer.",
+				Location: PathsCenter(outside),
+			})
+		}
+	}
+}
+
+// Get the center of the bounds of a set of paths.
+func PathsCenter(paths []Path) Point {
+	pts := make(Path, 0)
+	for _, path := range paths {
+		pts = append(pts, path...)
+	}
+	b := pts.Bounds()
+	return Point{(b.Xmin + b.Xmax) / 2, (b.Ymin + b.Ymax) / 2}
+}
@@ -0,0 +1,100 @@
+package kad
+
+import (
+	"log"
+	"math"
+)
+
+const (
+	FAB_LASER      = "laser"
+	FAB_CNC        = "cnc"
+	RELIEF_DOGBONE = "dogbone"
+	RELIEF_TBONE   = "t-bone"
+	RELIEF_FILLET  = "fillet"
+	RELIEF_ANGLE   = 30.0  // min change of direction in degrees for a corner to need relief
+	RELIEF_OVERCUT = 0.003 // distance in mm the relief cuts past the corner, so no sliver of the corner is left
+)
+
+// How the plates will be cut, which changes how the corners are drawn.
+type Fabrication struct {
+	Mode         string  `json:"mode"`          // 'laser' (default) or 'cnc'
+	ToolDiameter float64 `json:"tool-diameter"` // diameter of the end mill in mm
+	Relief       string  `json:"relief"`        // 'dogbone' (default), 't-bone' or 'fillet'
+}
+
+// Relieve the inside corners of a layer so they can be cut with a rotating end mill.
+// The 'fillet' relief rounds the outside corners to the tool radius as well.
+func (k *KAD) CornerRelief(layer string) bool {
+	if k.Fabrication.Mode != FAB_CNC || k.Fabrication.ToolDiameter <= 0 {
+		return true
+	}
+	r := k.Fabrication.ToolDiameter / 2
+	polys := k.Layers[layer].KeepPolys
+	arcs := k.LayerArcs(layer)
+	relief := k.Fabrication.Relief
+	switch relief {
+	case "", RELIEF_DOGBONE, RELIEF_TBONE:
+	case RELIEF_FILLET:
+		// round the corners to the tool radius, the corners which are already rounder are left alone
+		k.Layers[layer].KeepPolys = arcs.Fillet(polys, r, r)
+		return true
+	default:
+		log.Printf("ERROR unknown corner relief '%s', using a dogbone: %s, %s", relief, k.Hash, layer)
+		relief = RELIEF_DOGBONE
+	}
+
+	sign := OuterSign(polys)
+	reliefs := make([]Path, 0)
+	for _, path := range polys {
+		for _, c := range path.ReliefCenters(r, sign, relief == RELIEF_TBONE) {
+			reliefs = append(reliefs, arcs.Circle(c.X, c.Y, r, 20))
+		}
+	}
+	if len(reliefs) == 0 {
+		return true
+	}
+	relieved, ok := DifferencePaths(polys, reliefs)
+	if !ok {
+		log.Printf("ERROR adding corner relief: %s, %s", k.Hash, layer)
+		return false
+	}
+	k.Layers[layer].KeepPolys = relieved
+	return true
+}
+
+// Get the centers of the tool circles which clear the sharp inside corners of the path.
+// 'sign' is the orientation of the outer paths, so the inside corners of the material can be found.
+// A dogbone is centered on the corner bisector, while a t-bone is centered along the longer edge.
+func (ps Path) ReliefCenters(r, sign float64, tbone bool) Path {
+	centers := make(Path, 0)
+	n := len(ps)
+	for i := range ps {
+		prev, v, next := ps[(i+n-1)%n], ps[i], ps[(i+1)%n]
+		l1, l2 := math.Hypot(prev.X-v.X, prev.Y-v.Y), math.Hypot(next.X-v.X, next.Y-v.Y)
+		if l1 == 0 || l2 == 0 {
+			continue
+		}
+		d1 := Point{(prev.X - v.X) / l1, (prev.Y - v.Y) / l1}
+		d2 := Point{(next.X - v.X) / l2, (next.Y - v.Y) / l2}
+		cross := (v.X-prev.X)*(next.Y-v.Y) - (v.Y-prev.Y)*(next.X-v.X)
+		// skip the convex corners and the gentle corners of curves
+		turn := math.Acos(math.Max(-1, math.Min(1, -(d1.X*d2.X+d1.Y*d2.Y))))
+		if cross*sign >= 0 || turn*180/math.Pi < RELIEF_ANGLE {
+			continue
+		}
+		d := Point{d1.X + d2.X, d1.Y + d2.Y}
+		if tbone {
+			d = d1
+			if l2 > l1+1e-6 || (math.Abs(l2-l1) <= 1e-6 && math.Abs(d2.X) > math.Abs(d1.X)) {
+				d = d2 // prefer the longer edge, then the horizontal edge
+			}
+		}
+		l := math.Hypot(d.X, d.Y)
+		if l < 1e-9 {
+			continue
+		}
+		c := r - RELIEF_OVERCUT
+		centers = append(centers, Point{v.X + c*d.X/l, v.Y + c*d.Y/l})
+	}
+	return centers
+}
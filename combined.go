@@ -0,0 +1,105 @@
+package kad
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	svg "github.com/swill/svgo"
+)
+
+const (
+	COMBINED_SIDE_BY_SIDE = "side-by-side"
+	COMBINED_OVERLAY      = "overlay"
+	COMBINED_NAME         = "combined" // name used for the combined svg file
+	COMBINED_BOX_STYLE    = "fill:none;stroke-width:%fmm;stroke:%s;stroke-dasharray:1,1"
+)
+
+// Colors used to tell the layers apart when they are overlaid.
+var COMBINED_COLORS = []string{"black", "red", "blue", "green", "orange", "purple", "teal", "brown"}
+
+// A single svg with every plate in its own named group.
+type CombinedSvg struct {
+	Arrange string  `json:"arrange"` // 'side-by-side' or 'overlay', no combined svg is drawn if empty
+	Spacing float64 `json:"spacing"` // space in mm between the plates when they are side by side
+}
+
+// Draw all the plates into one svg, each plate is an inkscape layer which can be shown or hidden.
+func (k *KAD) DrawCombinedSvg() error {
+	c := k.CombinedSvg
+	if c.Arrange != COMBINED_SIDE_BY_SIDE && c.Arrange != COMBINED_OVERLAY {
+		return nil
+	}
+	layers := k.Result.Plates
+	if len(layers) == 0 {
+		return nil
+	}
+
+	// place the plates and get the size of the canvas
+	offsets := make([]float64, len(layers))
+	width, height := 0.0, 0.0
+	for i, layer := range layers {
+		w, h := k.Layers[layer].Width+2*k.DMZ, k.Layers[layer].Height+2*k.DMZ
+		if c.Arrange == COMBINED_SIDE_BY_SIDE {
+			offsets[i] = width
+			width += w
+			if i < len(layers)-1 {
+				width += c.Spacing
+			}
+		} else if w > width {
+			width = w
+		}
+		if h > height {
+			height = h
+		}
+	}
+
+	abs_svg, err := filepath.Abs(fmt.Sprintf("%s%s_%s.svg", k.FileDirectory, k.Hash, COMBINED_NAME))
+	if err != nil {
+		return err
+	}
+	file, err := os.Create(abs_svg)
+	if err != nil {
+		log.Printf("ERROR Creating combined export file: %s | %s", k.Hash, err.Error())
+		return err
+	}
+	defer file.Close()
+	canvas := svg.New(file)
+	canvas.FloatDecimals = 3
+	doc_width, doc_height, unit := k.DocumentSize(width, height)
+	canvas.StartunitF(doc_width, doc_height, unit,
+		fmt.Sprintf(`viewBox="0 0 %.3f %.3f"`, width, height),
+		`xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape"`)
+	for i, layer := range layers {
+		color := k.LineColor
+		if c.Arrange == COMBINED_OVERLAY {
+			color = COMBINED_COLORS[i%len(COMBINED_COLORS)]
+		}
+		name := layer
+		if d, ok := k.Result.Details[layer]; ok {
+			name = d.Name
+		}
+		canvas.Group(fmt.Sprintf(`id="%s"`, layer), `inkscape:groupmode="layer"`,
+			fmt.Sprintf(`inkscape:label="%s"`, name), fmt.Sprintf(`transform="translate(%.3f,0)"`, offsets[i]))
+		k.DrawSvgPolygons(canvas, k.Layers[layer].KeepPolys, k.LayerArcs(layer), fmt.Sprintf("fill:none;stroke-width:%fmm;stroke:%s", k.LineWeight, color))
+
+		// the bounding box of the outline, to check the alignment of the layers
+		pts := make(Path, 0)
+		for _, path := range k.Layers[layer].KeepPolys {
+			pts = append(pts, path...)
+		}
+		if len(pts) > 0 {
+			b := pts.Bounds()
+			canvas.RectF(b.Xmin, b.Ymin, b.Xmax-b.Xmin, b.Ymax-b.Ymin, fmt.Sprintf(COMBINED_BOX_STYLE, k.LineWeight, color))
+		}
+		canvas.Gend()
+	}
+	canvas.End()
+
+	k.Result.Combined = append(k.Result.Combined, Export{
+		Ext: "svg",
+		Url: fmt.Sprintf("%s%s_%s.svg", k.FileServePath, k.Hash, COMBINED_NAME),
+	})
+	return nil
+}
@@ -0,0 +1,75 @@
+package kad
+
+import (
+	"fmt"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	DRC_MIN_WEB     = "min-web"
+	DRC_MIN_FEATURE = "min-feature"
+	DRC_MIN_AREA    = 0.01 // ignore slivers smaller than this in mm^2
+	DRC_MARKER      = 2.0  // radius in mm of the markers drawn on the violations
+	DRC_STYLE       = "fill:none;stroke-width:0.2mm;stroke:red"
+)
+
+// Limits used by the design rule checks, a limit of zero is not checked.
+type DesignRules struct {
+	MinWeb     float64 `json:"min-web"`     // min width of the material left between cuts in mm
+	MinFeature float64 `json:"min-feature"` // min size of a hole in mm
+	Overlay    bool    `json:"overlay"`     // draw markers on the violations in the output files
+}
+
+// Check the final polygons of every layer against the design rules.
+func (k *KAD) CheckDesignRules() {
+	for _, layer := range k.Result.Plates {
+		polys := k.Layers[layer].KeepPolys
+		if k.DesignRules.MinWeb > 0 {
+			for _, thin := range ThinPaths(polys, k.DesignRules.MinWeb) {
+				b := thin.Bounds()
+				k.Result.Warnings = append(k.Result.Warnings, Warning{
+					Code:     DRC_MIN_WEB,
+					Layer:    layer,
+					Message:  fmt.Sprintf("Material is thinner than %.2fmm.", k.DesignRules.MinWeb),
+					Location: Point{(b.Xmin + b.Xmax) / 2, (b.Ymin + b.Ymax) / 2},
+				})
+			}
+		}
+		if k.DesignRules.MinFeature > 0 {
+			sign := OuterSign(polys)
+			for _, path := range polys {
+				if path.SignedArea()*sign >= 0 { // not a hole
+					continue
+				}
+				// a hole is too small if nothing is left once it is shrunk by half the limit
+				if len(OffsetPaths([]Path{path}, -k.DesignRules.MinFeature/2, clipper.JtMiter)) == 0 {
+					b := path.Bounds()
+					k.Result.Warnings = append(k.Result.Warnings, Warning{
+						Code:     DRC_MIN_FEATURE,
+						Layer:    layer,
+						Message:  fmt.Sprintf("Hole is smaller than %.2fmm.", k.DesignRules.MinFeature),
+						Location: Point{(b.Xmin + b.Xmax) / 2, (b.Ymin + b.Ymax) / 2},
+					})
+				}
+			}
+		}
+	}
+}
+
+// Get the areas of the paths which are thinner than 'width'.
+// Shrinking then growing the paths removes everything thinner than 'width', so the difference is what is too thin.
+func ThinPaths(paths []Path, width float64) []Path {
+	opened := OffsetPaths(OffsetPaths(paths, -width/2, clipper.JtMiter), width/2, clipper.JtMiter)
+	diff, ok := DifferencePaths(paths, opened)
+	thin := make([]Path, 0)
+	if !ok {
+		return thin
+	}
+	for _, path := range diff {
+		if SurfaceArea([]Path{path}) > DRC_MIN_AREA {
+			thin = append(thin, path)
+		}
+	}
+	return thin
+}
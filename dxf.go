@@ -0,0 +1,94 @@
+package kad
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+)
+
+// Write the layer as a DXF file with the arcs of the contours stored as polyline bulges.
+func (k *KAD) WriteDxf(layer string, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	height := k.Layers[layer].Height + 2*k.DMZ // dxf uses a y axis which points up
+
+	pair := func(code int, value interface{}) {
+		switch v := value.(type) {
+		case float64:
+			fmt.Fprintf(w, "%d\n%.4f\n", code, v)
+		default:
+			fmt.Fprintf(w, "%d\n%v\n", code, v)
+		}
+	}
+	pair(0, "SECTION")
+	pair(2, "HEADER")
+	pair(9, "$ACADVER")
+	pair(1, "AC1009") // R12 has no units, the drawing is in mm
+	pair(0, "ENDSEC")
+	pair(0, "SECTION")
+	pair(2, "ENTITIES")
+	for _, poly := range k.Layers[layer].KeepPolys {
+		if len(poly) == 0 {
+			continue
+		}
+		contour := poly.LineContour()
+		if k.TrueArcs {
+			contour = poly.Contour(k.LayerArcs(layer))
+		}
+		pair(0, "POLYLINE")
+		pair(8, layer)
+		pair(66, 1)
+		pair(70, 1) // closed
+		start := contour.Start
+		for _, s := range contour.Segments {
+			pair(0, "VERTEX")
+			pair(8, layer)
+			pair(10, start.X)
+			pair(20, height-start.Y)
+			if s.Radius > 0 {
+				bulge := math.Tan(SweepAngle(start, s.End, s.Center, s.Sweep) / 4)
+				if s.Sweep { // clockwise once the y axis is flipped
+					bulge = -bulge
+				}
+				pair(42, bulge)
+			}
+			start = s.End
+		}
+		pair(0, "SEQEND")
+		pair(8, layer)
+	}
+	if k.Engraving.Enabled() { // the engraving goes on its own layer in blue
+		engrave := fmt.Sprintf("%s_engrave", layer)
+		for _, mark := range k.AlignmentMarks(layer) {
+			pair(0, "LINE")
+			pair(8, engrave)
+			pair(62, 5)
+			pair(10, mark[0].X)
+			pair(20, height-mark[0].Y)
+			pair(11, mark[1].X)
+			pair(21, height-mark[1].Y)
+		}
+		for _, l := range k.Layers[layer].Labels {
+			pair(0, "TEXT")
+			pair(8, engrave)
+			pair(62, 5)
+			pair(10, l.At.X)
+			pair(20, height-l.At.Y)
+			pair(40, l.Size)
+			pair(1, l.Text)
+			pair(50, -l.Rotate)
+			pair(72, 1) // centered
+			pair(11, l.At.X)
+			pair(21, height-l.At.Y)
+			pair(73, 2) // middle
+		}
+	}
+	pair(0, "ENDSEC")
+	pair(0, "EOF")
+	return w.Flush()
+}
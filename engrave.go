@@ -0,0 +1,166 @@
+package kad
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+)
+
+const (
+	ENGRAVE_COLOR       = "blue"
+	ENGRAVE_CUT_COLOR   = "red"
+	ENGRAVE_TEXT_SIZE   = 3.0 // default height of the layer text in mm
+	ENGRAVE_LEGEND_SIZE = 2.0 // max height of the key legends in mm
+	ENGRAVE_MIN_SIZE    = 0.8 // text smaller than this in mm is not engraved
+	ENGRAVE_MARK        = 3.0 // size of the alignment marks in mm
+)
+
+var legend_tags = regexp.MustCompile(`<[^>]*>`)
+
+// Marking which is engraved on the plates rather than cut through them.
+type Engraving struct {
+	Legends   []string `json:"legends"`         // layers to engrave the key legends on
+	LayerText bool     `json:"layer-text"`      // engrave the layer name and serial on each plate
+	Serial    string   `json:"serial"`          // serial engraved after the layer name, defaults to the hash
+	Marks     bool     `json:"alignment-marks"` // add alignment marks outside the corners of each plate
+	TextSize  float64  `json:"text-size"`       // height of the layer text in mm
+	Color     string   `json:"color"`           // stroke color of the engraving, defaults to blue
+	CutColor  string   `json:"cut-color"`       // stroke color of the cuts when engraving and no line color is set, defaults to red
+}
+
+// Text engraved on a layer.
+type Label struct {
+	Text   string
+	At     Point   // center of the text
+	Size   float64 // height of the text in mm
+	Rotate float64 // rotation of the text in degrees
+}
+
+// Check if any engraving was requested.
+func (e Engraving) Enabled() bool {
+	return len(e.Legends) > 0 || e.LayerText || e.Marks
+}
+
+// Get the style used to draw the engraving.
+func (e Engraving) Style(lw float64) string {
+	return fmt.Sprintf("fill:none;stroke-width:%fmm;stroke:%s", lw, e.Color)
+}
+
+// Clean up a KLE label into a single line legend.
+func LegendText(s string) string {
+	parts := make([]string, 0)
+	for _, p := range strings.Split(legend_tags.ReplaceAllString(s, ""), "\n") {
+		if p = strings.TrimSpace(html.UnescapeString(p)); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Add the key legends and the layer text to the layers.
+func (k *KAD) DrawEngraving() {
+	e := k.Engraving
+	if e.TextSize <= 0 {
+		e.TextSize = ENGRAVE_TEXT_SIZE
+	}
+	keys_bottom := 0.0
+	for _, row := range k.Layout {
+		for _, key := range row {
+			keys_bottom = math.Max(keys_bottom, key.Bounds.Bounds().Ymax)
+		}
+	}
+
+	for _, layer := range e.Legends {
+		if !in_strings(layer, k.Result.Plates) {
+			continue
+		}
+		polys := k.Layers[layer].KeepPolys
+		for _, row := range k.Layout {
+			for _, key := range row {
+				if key.Label == "" {
+					continue
+				}
+				// measure in the frame of the key, where the switch opening is upright
+				bounds := key.Bounds.Copy()
+				bounds.RotatePath(-key.Angle, key.Center)
+				b := bounds.Bounds()
+				at := Path{key.Center}
+				size := ENGRAVE_LEGEND_SIZE
+				if !PointInPaths(key.Center, polys) && len(key.Cutouts) > 0 { // move above the switch opening
+					top := b.Ymax
+					for _, cut := range key.Cutouts {
+						upright := cut.Copy()
+						upright.RotatePath(-key.Angle, key.Center)
+						top = math.Min(top, upright.Bounds().Ymin)
+					}
+					at[0].Y = (b.Ymin + top) / 2
+					at.RotatePath(key.Angle, key.Center)
+					size = math.Min(size, (top-b.Ymin)*0.8)
+				}
+				if size >= ENGRAVE_MIN_SIZE && PointInPaths(at[0], polys) {
+					k.Layers[layer].Labels = append(k.Layers[layer].Labels,
+						Label{Text: key.Label, At: at[0], Size: size, Rotate: key.Angle})
+				}
+			}
+		}
+	}
+
+	if e.LayerText {
+		serial := e.Serial
+		if serial == "" {
+			serial = k.Hash
+		}
+		for _, layer := range k.Result.Plates {
+			polys := k.Layers[layer].KeepPolys
+			pts := make(Path, 0)
+			for _, path := range polys {
+				pts = append(pts, path...)
+			}
+			b := pts.Bounds()
+			// center the text in the frame below the keys
+			at := Point{(b.Xmin + b.Xmax) / 2, (keys_bottom + b.Ymax) / 2}
+			size := math.Min(e.TextSize, (b.Ymax-keys_bottom)*0.6)
+			if size >= ENGRAVE_MIN_SIZE && PointInPaths(at, polys) {
+				text := strings.TrimSpace(fmt.Sprintf("%s %s", k.Result.Details[layer].Name, serial))
+				k.Layers[layer].Labels = append(k.Layers[layer].Labels, Label{Text: text, At: at, Size: size})
+			}
+		}
+	}
+}
+
+// Get the alignment marks just outside the corners of a layer, as pairs of points to draw lines between.
+// Stock sheets do not get marks since the plates are already placed on them.
+func (k *KAD) AlignmentMarks(layer string) []Path {
+	marks := make([]Path, 0)
+	if !k.Engraving.Marks || in_strings(layer, k.Result.Sheets) {
+		return marks
+	}
+	s := math.Min(ENGRAVE_MARK, k.DMZ) / 2
+	w, h := k.Layers[layer].Width, k.Layers[layer].Height
+	for _, c := range []Point{{0, 0}, {w, 0}, {w, h}, {0, h}} {
+		// center the marks in the dmz, diagonally out from the corner
+		x := c.X + k.DMZ/2
+		if c.X > 0 {
+			x = c.X + k.DMZ*3/2
+		}
+		y := c.Y + k.DMZ/2
+		if c.Y > 0 {
+			y = c.Y + k.DMZ*3/2
+		}
+		marks = append(marks, Path{{x - s, y}, {x + s, y}}, Path{{x, y - s}, {x, y + s}})
+	}
+	return marks
+}
+
+// Write the engraved text as an SVG text element.
+func (l Label) WriteSvg(w io.Writer, color string) {
+	transform := ""
+	if l.Rotate != 0 {
+		transform = fmt.Sprintf(` transform="rotate(%.3f %.3f %.3f)"`, l.Rotate, l.At.X, l.At.Y)
+	}
+	fmt.Fprintf(w, `<text x="%.3f" y="%.3f" font-size="%.3f" text-anchor="middle" dominant-baseline="central"%s style="fill:%s;stroke:none;font-family:sans-serif">%s</text>`+"\n",
+		l.At.X, l.At.Y, l.Size, transform, color, html.EscapeString(l.Text))
+}
@@ -0,0 +1,135 @@
+package kad
+
+import (
+	"math"
+	"sort"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	FLEX_ROWS       = "rows"
+	FLEX_COLUMNS    = "columns"
+	FLEX_SPINE_FREE = "spine-free"
+	FLEX_WIDTH      = 1.5 // default width of the slots in mm
+	FLEX_CLEARANCE  = 1.0 // default min distance in mm between a slot and a cutout
+	FLEX_MIN_LENGTH = 3.0 // slots shorter than this in mm are skipped
+)
+
+// Relief slots cut into the switch plate to make it flexible.
+type FlexCuts struct {
+	Pattern   string  `json:"pattern"`     // 'rows', 'columns' or 'spine-free'
+	Width     float64 `json:"slot-width"`  // width of the slots in mm
+	Radius    float64 `json:"slot-radius"` // radius of the ends of the slots in mm, defaults to half the width
+	Clearance float64 `json:"clearance"`   // min distance in mm between a slot and the cutouts
+}
+
+// Draw the flex cut slots on the switch layer along the rows or columns of the keys.
+// 'rows' and 'columns' keep the slots inside the keys, while 'spine-free' runs the row slots out to
+// alternate sides of the case so there is no solid spine left down either side of the plate.
+func (k *KAD) DrawFlexCuts() {
+	f := k.FlexCuts
+	if f.Pattern == "" || !in_strings(SWITCHLAYER, k.Result.Plates) {
+		return
+	}
+	if f.Width <= 0 {
+		f.Width = FLEX_WIDTH
+	}
+	if f.Radius <= 0 || f.Radius > f.Width/2 {
+		f.Radius = f.Width / 2
+	}
+	if f.Clearance <= 0 {
+		f.Clearance = FLEX_CLEARANCE
+	}
+
+	// the center line of a slot has to stay this far from the cutouts to keep the clearance
+	cuts, _ := UnionPaths(k.Layers[SWITCHLAYER].CutPolys)
+	blocked := OffsetPaths(cuts, f.Clearance+f.Width/2, clipper.JtRound)
+	keys := k.LayoutOutline(0, 0, 0, 0)
+	region := OffsetPaths(keys, -f.Width/2, clipper.JtMiter)
+	if f.Pattern == FLEX_SPINE_FREE {
+		region = OffsetPaths(k.CaseOutline(nil), -(f.Clearance + f.Width/2), clipper.JtMiter)
+	}
+
+	// the lines between the rows and columns of keys
+	xs, ys := make([]float64, 0), make([]float64, 0)
+	for _, row := range k.Layout {
+		for _, key := range row {
+			b := key.Bounds.Bounds()
+			xs = append(xs, b.Xmin+OVERLAP, b.Xmax-OVERLAP)
+			ys = append(ys, b.Ymin+OVERLAP, b.Ymax-OVERLAP)
+		}
+	}
+
+	slots := make([]Path, 0)
+	switch f.Pattern {
+	case FLEX_COLUMNS:
+		for _, x := range InnerLines(xs) {
+			for _, iv := range SubtractSpans(LineSpans(region, x), LineSpans(blocked, x)) {
+				if iv[1]-iv[0] >= FLEX_MIN_LENGTH {
+					slots = append(slots, k.Arcs.RoundRectangle(x, (iv[0]+iv[1])/2,
+						f.Width, iv[1]-iv[0]+2*f.Radius, f.Radius, 5))
+				}
+			}
+		}
+	default: // rows
+		t_region, t_blocked, t_keys := TransposePaths(region), TransposePaths(blocked), TransposePaths(keys)
+		for i, y := range InnerLines(ys) {
+			spans := SubtractSpans(LineSpans(t_region, y), LineSpans(t_blocked, y))
+			if key_spans := LineSpans(t_keys, y); f.Pattern == FLEX_SPINE_FREE && len(key_spans) > 0 {
+				// only open up one side of the case for each row
+				for j := range spans {
+					if i%2 == 0 {
+						spans[j][1] = math.Min(spans[j][1], key_spans[len(key_spans)-1][1]-f.Width/2)
+					} else {
+						spans[j][0] = math.Max(spans[j][0], key_spans[0][0]+f.Width/2)
+					}
+				}
+			}
+			for _, iv := range spans {
+				if iv[1]-iv[0] >= FLEX_MIN_LENGTH {
+					slots = append(slots, k.Arcs.RoundRectangle((iv[0]+iv[1])/2, y,
+						iv[1]-iv[0]+2*f.Radius, f.Width, f.Radius, 5))
+				}
+			}
+		}
+	}
+	k.Layers[SWITCHLAYER].CutPolys = append(k.Layers[SWITCHLAYER].CutPolys, slots...)
+}
+
+// Get the distinct lines between the keys, without the outside edges of the layout.
+func InnerLines(values []float64) []float64 {
+	sort.Float64s(values)
+	lines := make([]float64, 0)
+	for _, v := range values {
+		v = math.Round(v*100) / 100
+		if len(lines) == 0 || v-lines[len(lines)-1] > 0.05 {
+			lines = append(lines, v)
+		}
+	}
+	if len(lines) < 3 {
+		return []float64{}
+	}
+	return lines[1 : len(lines)-1]
+}
+
+// Remove the 'remove' spans from the 'spans', both are sorted and do not overlap themselves.
+func SubtractSpans(spans, remove [][2]float64) [][2]float64 {
+	result := make([][2]float64, 0)
+	for _, s := range spans {
+		lo := s[0]
+		for _, r := range remove {
+			if r[1] <= lo || r[0] >= s[1] {
+				continue
+			}
+			if r[0] > lo {
+				result = append(result, [2]float64{lo, r[0]})
+			}
+			lo = math.Max(lo, r[1])
+		}
+		if lo < s[1] {
+			result = append(result, [2]float64{lo, s[1]})
+		}
+	}
+	return result
+}
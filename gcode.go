@@ -0,0 +1,225 @@
+package kad
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	GCODE_FEED        = 600.0   // default cutting feed rate in mm/min
+	GCODE_SPEED       = 12000.0 // default spindle speed in rpm
+	GCODE_POWER       = 1000.0  // default laser power
+	GCODE_DEPTH       = 1.5     // default total depth in mm when there is no material thickness
+	GCODE_SAFE_Z      = 5.0     // default height in mm for rapid moves
+	GCODE_TAB_WIDTH   = 4.0     // default width of the tabs in mm
+	GCODE_TAB_HEIGHT  = 0.5     // default height of the tabs in mm
+	GCODE_PLUNGE_RATE = 3.0     // the default plunge feed is the feed divided by this
+)
+
+// Settings for the G-code exported for CNC routers and laser cutters.
+// The 'fabrication' mode decides if the G-code drives a spindle and Z axis or a laser.
+type GcodeOptions struct {
+	Feed         float64 `json:"feed"`           // cutting feed rate in mm/min
+	PlungeFeed   float64 `json:"plunge-feed"`    // plunge feed rate in mm/min
+	Speed        float64 `json:"speed"`          // spindle speed in rpm, or the laser power
+	DepthPerPass float64 `json:"depth-per-pass"` // depth of each pass in mm
+	Depth        float64 `json:"depth"`          // total depth in mm, defaults to the material thickness
+	SafeZ        float64 `json:"safe-z"`         // height for the rapid moves in mm
+	Tabs         int     `json:"tabs"`           // number of tabs holding each outer contour in the sheet
+	TabWidth     float64 `json:"tab-width"`      // width of the tabs in mm
+	TabHeight    float64 `json:"tab-height"`     // height of the tabs in mm
+}
+
+// Get the tool paths of a layer in the order they should be cut, with the inner contours before the outer ones.
+// A CNC tool path is always the tool radius away from the design, so any kerf already applied to the polygons is taken back out.
+func (k *KAD) GcodeContours(layer string) []Path {
+	polys := k.Layers[layer].KeepPolys
+	if k.Fabrication.Mode == FAB_CNC && k.Fabrication.ToolDiameter > 0 {
+		polys = OffsetPaths(polys, k.Fabrication.ToolDiameter/2-k.Kerf, clipper.JtRound)
+	}
+	return CutOrder(polys)
+}
+
+// Get the contours in the order they should be cut, with the inner contours before the outer ones
+// so the parts do not move before their holes are cut.
+func CutOrder(polys []Path) []Path {
+	sign := OuterSign(polys)
+	inner, outer := make([]Path, 0), make([]Path, 0)
+	for _, poly := range polys {
+		if len(poly) < 2 {
+			continue
+		}
+		if poly.SignedArea()*sign > 0 {
+			outer = append(outer, poly)
+		} else {
+			inner = append(inner, poly)
+		}
+	}
+	// cut the nearest contour next to keep the rapid moves short
+	at := Point{0, 0}
+	ordered := make([]Path, 0)
+	for _, group := range [][]Path{inner, outer} {
+		for len(group) > 0 {
+			sort.SliceStable(group, func(i, j int) bool {
+				return math.Hypot(group[i][0].X-at.X, group[i][0].Y-at.Y) < math.Hypot(group[j][0].X-at.X, group[j][0].Y-at.Y)
+			})
+			ordered = append(ordered, group[0])
+			at = group[0][0]
+			group = group[1:]
+		}
+	}
+	return ordered
+}
+
+// Write the G-code to cut a layer.
+func (k *KAD) WriteGcode(layer string, path string) error {
+	g := k.Gcode
+	laser := k.Fabrication.Mode != FAB_CNC
+	if g.Feed <= 0 {
+		g.Feed = GCODE_FEED
+	}
+	if g.PlungeFeed <= 0 {
+		g.PlungeFeed = g.Feed / GCODE_PLUNGE_RATE
+	}
+	if g.Speed <= 0 && laser {
+		g.Speed = GCODE_POWER
+	} else if g.Speed <= 0 {
+		g.Speed = GCODE_SPEED
+	}
+	if g.Depth <= 0 {
+		g.Depth = k.Material.Thickness
+	}
+	if g.Depth <= 0 {
+		g.Depth = GCODE_DEPTH
+	}
+	if g.DepthPerPass <= 0 || g.DepthPerPass > g.Depth {
+		g.DepthPerPass = g.Depth
+	}
+	if g.SafeZ <= 0 {
+		g.SafeZ = GCODE_SAFE_Z
+	}
+	if g.TabWidth <= 0 {
+		g.TabWidth = GCODE_TAB_WIDTH
+	}
+	if g.TabHeight <= 0 || g.TabHeight >= g.Depth {
+		g.TabHeight = GCODE_TAB_HEIGHT
+	}
+	depths := make([]float64, 0)
+	for d := g.DepthPerPass; d < g.Depth-1e-6; d += g.DepthPerPass {
+		depths = append(depths, d)
+	}
+	depths = append(depths, g.Depth)
+	tab_z := -(g.Depth - g.TabHeight)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	height := k.Layers[layer].Height + 2*k.DMZ // g-code uses a y axis which points up
+	xy := func(p Point) string {
+		return fmt.Sprintf("X%.3f Y%.3f", p.X, height-p.Y)
+	}
+
+	fmt.Fprintf(w, "(%s %s)\nG21\nG90\n", k.Hash, layer)
+	if laser {
+		fmt.Fprintf(w, "M4 S0\n") // dynamic power, so the laser is off during rapid moves
+	} else {
+		fmt.Fprintf(w, "G0 Z%.3f\nM3 S%.0f\nG4 P2\n", g.SafeZ, g.Speed)
+	}
+	contours := k.GcodeContours(layer)
+	sign := OuterSign(contours)
+	for _, poly := range contours {
+		tabs := 0
+		if poly.SignedArea()*sign > 0 {
+			tabs = g.Tabs
+		}
+		pts, on_tab := poly.TabWalk(tabs, g.TabWidth)
+		fmt.Fprintf(w, "G0 %s\n", xy(poly[0]))
+		for _, d := range depths {
+			if laser {
+				for i, p := range pts {
+					power := g.Speed
+					if on_tab[i] {
+						power = 0
+					}
+					fmt.Fprintf(w, "G1 %s F%.0f S%.0f\n", xy(p), g.Feed, power)
+				}
+				continue
+			}
+			fmt.Fprintf(w, "G1 Z%.3f F%.0f\n", -d, g.PlungeFeed)
+			z := -d
+			for i, p := range pts {
+				want := -d
+				if on_tab[i] && -d < tab_z {
+					want = tab_z
+				}
+				if want != z { // climb onto or drop off the tab
+					fmt.Fprintf(w, "G1 Z%.3f F%.0f\n", want, g.PlungeFeed)
+					z = want
+				}
+				fmt.Fprintf(w, "G1 %s F%.0f\n", xy(p), g.Feed)
+			}
+			if z != -d {
+				fmt.Fprintf(w, "G1 Z%.3f F%.0f\n", -d, g.PlungeFeed)
+			}
+		}
+		if !laser {
+			fmt.Fprintf(w, "G0 Z%.3f\n", g.SafeZ)
+		}
+	}
+	fmt.Fprintf(w, "M5\nG0 X0 Y0\nM2\n")
+	return w.Flush()
+}
+
+// Walk around the closed path from its first point, splitting the edges at the ends of the tabs.
+// Each point is returned with whether the edge leading to it is on one of the evenly spaced tabs.
+func (ps Path) TabWalk(tabs int, width float64) (Path, []bool) {
+	total := 0.0
+	for i := range ps {
+		j := (i + 1) % len(ps)
+		total += math.Hypot(ps[j].X-ps[i].X, ps[j].Y-ps[i].Y)
+	}
+	bounds := make([]float64, 0)
+	for t := 0; t < tabs; t++ {
+		c := total * (float64(t) + 0.5) / float64(tabs)
+		bounds = append(bounds, c-width/2, c+width/2)
+	}
+	on_tab := func(s float64) bool {
+		for t := 0; t+1 < len(bounds); t += 2 {
+			if s > bounds[t] && s < bounds[t+1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	pts, flags := make(Path, 0), make([]bool, 0)
+	s := 0.0
+	for i := range ps {
+		a, b := ps[i], ps[(i+1)%len(ps)]
+		l := math.Hypot(b.X-a.X, b.Y-a.Y)
+		if l == 0 {
+			continue
+		}
+		prev := s
+		for _, t := range bounds {
+			if t > s && t < s+l {
+				f := (t - s) / l
+				pts = append(pts, Point{a.X + (b.X-a.X)*f, a.Y + (b.Y-a.Y)*f})
+				flags = append(flags, on_tab((prev+t)/2))
+				prev = t
+			}
+		}
+		pts = append(pts, b)
+		flags = append(flags, on_tab((prev+s+l)/2))
+		s += l
+	}
+	return pts, flags
+}
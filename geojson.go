@@ -0,0 +1,103 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+)
+
+// A GeoJSON feature collection, with the coordinates in mm and the y axis pointing up.
+type GeoCollection struct {
+	Type     string       `json:"type"`
+	Name     string       `json:"name"`
+	Units    string       `json:"units"`
+	Features []GeoFeature `json:"features"`
+}
+
+type GeoFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   GeoGeometry            `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type GeoGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// Get the geometry of a layer as GeoJSON.
+// Each outer contour is a polygon with its holes, and the layers stacked in the case also have the
+// key centers and mount holes as labelled points.
+func (k *KAD) LayerGeoJson(layer string) GeoCollection {
+	height := k.Layers[layer].Height + 2*k.DMZ
+	geo := GeoCollection{Type: "FeatureCollection", Name: layer, Units: "mm", Features: []GeoFeature{}}
+	coord := func(p Point) [2]float64 {
+		return [2]float64{math.Round(p.X*1e4) / 1e4, math.Round(p.Y*1e4) / 1e4}
+	}
+
+	for i, polygon := range NestPaths(k.LayerPathsUp(layer)) {
+		rings := make([][][2]float64, 0)
+		area := 0.0
+		for _, path := range polygon {
+			ring := make([][2]float64, 0)
+			for _, p := range path {
+				ring = append(ring, coord(p))
+			}
+			rings = append(rings, append(ring, coord(path[0]))) // the rings are closed
+			area += path.SignedArea()
+		}
+		geo.Features = append(geo.Features, GeoFeature{
+			Type:     "Feature",
+			Geometry: GeoGeometry{Type: "Polygon", Coordinates: rings},
+			Properties: map[string]interface{}{
+				"kind":  "contour",
+				"index": i,
+				"holes": len(polygon) - 1,
+				"area":  math.Round(area*100) / 100,
+			},
+		})
+	}
+
+	// the keys and the mount holes are only in the same place on the layers stacked in the case
+	if !in_strings(layer, MODEL_STACK) {
+		return geo
+	}
+	for r, row := range k.Layout {
+		for c, key := range row {
+			geo.Features = append(geo.Features, GeoFeature{
+				Type:     "Feature",
+				Geometry: GeoGeometry{Type: "Point", Coordinates: coord(Point{key.Center.X, height - key.Center.Y})},
+				Properties: map[string]interface{}{
+					"kind":     "key",
+					"row":      r,
+					"column":   c,
+					"label":    key.Label,
+					"width":    key.Width,
+					"height":   key.Height,
+					"rotation": key.Angle,
+				},
+			})
+		}
+	}
+	for i, h := range k.MountHoles {
+		geo.Features = append(geo.Features, GeoFeature{
+			Type:     "Feature",
+			Geometry: GeoGeometry{Type: "Point", Coordinates: coord(Point{h.X, height - h.Y})},
+			Properties: map[string]interface{}{
+				"kind":     "mount-hole",
+				"index":    i,
+				"diameter": k.Case.HoleDiameter,
+			},
+		})
+	}
+	return geo
+}
+
+// Write the geometry of a layer to a GeoJSON file.
+func (k *KAD) WriteGeoJson(layer string, path string) error {
+	data, err := json.MarshalIndent(k.LayerGeoJson(layer), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
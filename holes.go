@@ -0,0 +1,255 @@
+package kad
+
+import (
+	"fmt"
+	"math"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	HOLE_CLEARANCE = 1.0 // default clearance in mm between a placed hole and the switch cutouts
+	HOLE_STEP      = 1.0 // distance in mm between the candidate positions along the case edge
+	CORNER_ANGLE   = 60  // minimum turn in degrees for a change of direction to be a corner
+	CORNER_SPAN    = 5.0 // distance in mm within which the segments of a rounded corner are found
+	HOLE_CONFLICT  = "hole-conflict"
+)
+
+// Draw the mount holes which are positioned explicitly or by the hole placer.
+func (k *KAD) DrawPlacedHoles() {
+	points := k.GetPlacedHoles()
+	k.CheckHoles(points)
+	layers := []string{SWITCHLAYER}
+	if k.Case.Type == CASE_SANDWICH {
+		layers = k.Result.Plates
+	}
+	for _, layer := range layers {
+		for i := range points {
+			// create circle polygons with 5 segments per 1/4 turn
+			k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys,
+				k.Arcs.Circle(points[i].X, points[i].Y, k.Case.HoleDiameter/2, 5))
+		}
+	}
+}
+
+// Get the Path for mount holes which are positioned explicitly or by the hole placer.
+func (k *KAD) GetPlacedHoles() Path {
+	points := make(Path, 0)
+	if k.Case.HolePoints != "" { // explicit hole coordinates relative to the center of the case
+		for _, path := range k.ParsePoints(k.Case.HolePoints, "[0,0]", true) {
+			points = append(points, path...)
+		}
+		return points
+	}
+	if k.Case.Holes < 1 {
+		return points
+	}
+
+	var candidates, corners Path
+	switch k.Case.HolePlacement {
+	case HOLES_EDGE:
+		candidates, corners = k.EdgeHoleCandidates()
+	case HOLES_TRAY:
+		candidates = k.TrayHoleCandidates()
+	}
+
+	// only keep the candidates which have enough clearance from the switch cutouts
+	valid, valid_corners := make(Path, 0), make(Path, 0)
+	for _, pt := range candidates {
+		if _, conflict := k.HoleConflicts(pt, k.HoleClearance()); !conflict {
+			valid = append(valid, pt)
+		}
+	}
+	for _, pt := range corners {
+		if _, conflict := k.HoleConflicts(pt, k.HoleClearance()); !conflict {
+			valid_corners = append(valid_corners, pt)
+		}
+	}
+	if len(valid) < k.Case.Holes {
+		valid = candidates // not enough space, so the conflicts will be reported
+	}
+	// the corners are used first and the remaining holes are spread out between them
+	return SpreadPoints(valid, k.Case.Holes, SpreadPoints(valid_corners, k.Case.Holes, nil))
+}
+
+// Get the clearance to keep between the placed holes and the switch cutouts.
+func (k *KAD) HoleClearance() float64 {
+	if k.Case.HoleClearance > 0 {
+		return k.Case.HoleClearance
+	}
+	return HOLE_CLEARANCE
+}
+
+// Get the candidate hole positions and the corners along the middle of the case edge.
+func (k *KAD) EdgeHoleCandidates() (Path, Path) {
+	edge := k.Case.EdgeWidth
+	if edge <= 0 {
+		edge = math.Min(math.Min(k.LeftPad, k.RightPad), math.Min(k.TopPad, k.BottomPad))
+	}
+	ring := OffsetPaths(k.CaseOutline(nil), -edge/2, clipper.JtMiter)
+	return RingPoints(ring, HOLE_STEP), RingCorners(ring, CORNER_ANGLE, CORNER_SPAN)
+}
+
+// Get points spaced every 'step' along a set of closed paths.
+func RingPoints(paths []Path, step float64) Path {
+	points := make(Path, 0)
+	for _, path := range paths {
+		for i := range path {
+			a, b := path[i], path[(i+1)%len(path)]
+			dist := math.Hypot(b.X-a.X, b.Y-a.Y)
+			for d := 0.0; d < dist; d += step {
+				points = append(points, Point{a.X + (b.X-a.X)*d/dist, a.Y + (b.Y-a.Y)*d/dist})
+			}
+		}
+	}
+	return points
+}
+
+// Get the corners of a set of closed paths where the path turns by at least 'angle' degrees.
+// Turns within 'span' mm of each other (such as the segments of a fillet) are treated as one corner.
+func RingCorners(paths []Path, angle, span float64) Path {
+	corners := make(Path, 0)
+	for _, path := range paths {
+		n := len(path)
+		if n < 3 {
+			continue
+		}
+		turn := func(i int) float64 { // the change of direction at each point in degrees
+			a, b, c := path[(i+n-1)%n], path[i], path[(i+1)%n]
+			t := math.Atan2(c.Y-b.Y, c.X-b.X) - math.Atan2(b.Y-a.Y, b.X-a.X)
+			return math.Abs(math.Remainder(t, 2*math.Pi)) * 180 / math.Pi
+		}
+		dist := func(i, j int) float64 {
+			return math.Hypot(path[j].X-path[i].X, path[j].Y-path[i].Y)
+		}
+		// start after a long side so a corner is never split across the start of the path
+		start := 0
+		for i := range path {
+			if dist(i, (i+1)%n) > dist(start, (start+1)%n) {
+				start = i
+			}
+		}
+		group, total := make([]int, 0), 0.0
+		flush := func() {
+			if len(group) > 0 && total >= angle {
+				corners = append(corners, path[group[len(group)/2]])
+			}
+			group, total = make([]int, 0), 0.0
+		}
+		for j := 1; j <= n; j++ {
+			i := (start + j) % n
+			t := turn(i)
+			if t < 1 {
+				continue
+			}
+			if len(group) > 0 && dist(group[len(group)-1], i) > span {
+				flush()
+			}
+			group = append(group, i)
+			total += t
+		}
+		flush()
+	}
+	return corners
+}
+
+// Get the candidate hole positions inside the plate between the switches (tray mount).
+func (k *KAD) TrayHoleCandidates() Path {
+	// keep the holes within the area covered by the keys
+	r := k.Case.HoleDiameter/2 + k.HoleClearance()
+	inside := OffsetPaths(k.LayoutOutline(0, 0, 0, 0), -r, clipper.JtMiter)
+	candidates := make(Path, 0)
+	for _, row := range k.Layout {
+		for _, key := range row {
+			for i := range key.Bounds { // the corners and the middle of the sides of the key
+				a, b := key.Bounds[i], key.Bounds[(i+1)%len(key.Bounds)]
+				for _, pt := range []Point{a, {(a.X + b.X) / 2, (a.Y + b.Y) / 2}} {
+					if PointInPaths(pt, inside) && !in_points(pt, candidates, HOLE_STEP/2) {
+						candidates = append(candidates, pt)
+					}
+				}
+			}
+		}
+	}
+	return candidates
+}
+
+// Check if a hole at 'pt' with the additional 'clearance' would cut into the switch cutouts,
+// the connector openings or the bottom features, and get the layer of the cut it runs into.
+// The top layer only has the openings around the keys, so it is not checked.
+func (k *KAD) HoleConflicts(pt Point, clearance float64) (string, bool) {
+	r := k.Case.HoleDiameter/2 + clearance
+	hole := CirclePolygon(pt.X, pt.Y, r, 5)
+	for _, layer := range k.Result.Plates {
+		if layer == TOPLAYER {
+			continue
+		}
+		for _, poly := range k.Layers[layer].CutPolys {
+			b := poly.Bounds()
+			if pt.X+r < b.Xmin || pt.X-r > b.Xmax || pt.Y+r < b.Ymin || pt.Y-r > b.Ymax {
+				continue
+			}
+			if PathsIntersect([]Path{hole}, []Path{poly}) {
+				return layer, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Keep track of the mount holes and report the ones which cut into the other cutouts.
+func (k *KAD) CheckHoles(points Path) {
+	k.MountHoles = points.Copy()
+	for i, pt := range points {
+		if layer, conflict := k.HoleConflicts(pt, 0); conflict {
+			k.Result.Warnings = append(k.Result.Warnings, Warning{
+				Code:     HOLE_CONFLICT,
+				Layer:    layer,
+				Message:  fmt.Sprintf("Mount hole %d intersects a cutout of the %s layer.", i, layer),
+				Location: pt,
+			})
+		}
+	}
+}
+
+// Pick points from the candidates, in addition to the points already 'picked', until there are 'n' points.
+// The points are spread as far apart as possible, starting with the candidate closest to the top left.
+func SpreadPoints(candidates Path, n int, picked Path) Path {
+	points := picked.Copy()
+	if len(candidates) == 0 {
+		return points
+	}
+	if len(points) == 0 && n > 0 {
+		b := candidates.Bounds()
+		first := 0
+		for i, pt := range candidates {
+			if pt.X-b.Xmin+pt.Y-b.Ymin < candidates[first].X-b.Xmin+candidates[first].Y-b.Ymin {
+				first = i
+			}
+		}
+		points = append(points, candidates[first])
+	}
+	dists := make([]float64, len(candidates)) // distance from each candidate to the closest picked point
+	for i, pt := range candidates {
+		dists[i] = math.Inf(1)
+		for _, p := range points {
+			dists[i] = math.Min(dists[i], math.Hypot(pt.X-p.X, pt.Y-p.Y))
+		}
+	}
+	for len(points) < n {
+		next := 0
+		for i := range candidates {
+			if dists[i] > dists[next] {
+				next = i
+			}
+		}
+		if dists[next] == 0 { // every candidate has been picked
+			break
+		}
+		points = append(points, candidates[next])
+		for i, pt := range candidates {
+			dists[i] = math.Min(dists[i], math.Hypot(pt.X-candidates[next].X, pt.Y-candidates[next].Y))
+		}
+	}
+	return points
+}
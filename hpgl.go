@@ -0,0 +1,69 @@
+package kad
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	HPGL_UNITS       = 40.0 // plotter units per mm
+	HPGL_CUT_PEN     = 1
+	HPGL_ENGRAVE_PEN = 2
+)
+
+// Write the layer as HPGL for plotters and older laser cutters.
+// The cuts are drawn with pen 1, inner contours first like the G-code but without any tool offset, and the engraving with pen 2.
+func (k *KAD) WriteHpgl(layer string, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	height := k.Layers[layer].Height + 2*k.DMZ // hpgl uses a y axis which points up
+	xy := func(p Point) string {
+		return fmt.Sprintf("%d,%d", int(math.Round(p.X*HPGL_UNITS)), int(math.Round((height-p.Y)*HPGL_UNITS)))
+	}
+	draw := func(path Path, closed bool) {
+		pts := make([]string, 0)
+		for _, p := range path[1:] {
+			pts = append(pts, xy(p))
+		}
+		if closed {
+			pts = append(pts, xy(path[0]))
+		}
+		fmt.Fprintf(w, "PU%s;\nPD%s;\n", xy(path[0]), strings.Join(pts, ","))
+	}
+
+	fmt.Fprintf(w, "IN;\nSP%d;\n", HPGL_CUT_PEN)
+	for _, poly := range CutOrder(k.Layers[layer].KeepPolys) {
+		draw(poly, true)
+	}
+	if k.Engraving.Enabled() {
+		fmt.Fprintf(w, "SP%d;\n", HPGL_ENGRAVE_PEN)
+		for _, mark := range k.AlignmentMarks(layer) {
+			draw(mark, false)
+		}
+		for _, l := range k.Layers[layer].Labels {
+			// the character size is in cm and the text is centered on its position
+			a := radians(-l.Rotate) // the rotation turns the other way once the y axis is flipped
+			fmt.Fprintf(w, "DI%.4f,%.4f;SI%.3f,%.3f;LO5;PU%s;LB%s\x03;\n",
+				math.Cos(a), math.Sin(a), l.Size*0.6/10, l.Size/10, xy(l.At), HpglText(l.Text))
+		}
+	}
+	fmt.Fprintf(w, "PU;SP0;\n")
+	return w.Flush()
+}
+
+// Get the text of a label with only the characters a plotter can draw.
+func HpglText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r > '~' {
+			return '?'
+		}
+		return r
+	}, s)
+}
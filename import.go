@@ -0,0 +1,679 @@
+package kad
+
+import (
+	"encoding/xml"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	IMPORT_ARC_STEP       = 4.5 // max angle in degrees covered by each segment of an imported arc
+	IMPORT_CURVE_SEGMENTS = 16  // number of segments each imported bezier curve is split into
+)
+
+// A closed outline imported from an SVG or DXF drawing.
+// The outline is scaled and then centered on the layout (or on the points of a custom polygon).
+type Import struct {
+	Svg   string  `json:"svg"`   // svg path data or a whole svg document
+	Dxf   string  `json:"dxf"`   // dxf document with closed polylines
+	Scale float64 `json:"scale"` // multiplier to convert the units of the drawing to mm, defaults to 1
+}
+
+// Check if there is a drawing to import.
+func (im Import) Enabled() bool {
+	return strings.TrimSpace(im.Svg) != "" || strings.TrimSpace(im.Dxf) != ""
+}
+
+// Get the closed paths of the imported drawing in mm with the y axis pointing down.
+// Paths inside other paths become holes (even-odd rule).
+func (im Import) Paths() []Path {
+	paths := make([]Path, 0)
+	if strings.TrimSpace(im.Svg) != "" {
+		paths = append(paths, ParseSvgOutline(im.Svg)...)
+	}
+	if strings.TrimSpace(im.Dxf) != "" {
+		paths = append(paths, ParseDxfOutline(im.Dxf)...)
+	}
+	scale := im.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+	c := clipper.NewClipper(clipper.IoNone)
+	for _, path := range paths {
+		scaled := make(Path, len(path))
+		for i, pt := range path {
+			scaled[i] = Point{pt.X * scale, pt.Y * scale}
+		}
+		c.AddPath(scaled.ToClipperPath(), clipper.PtSubject, true)
+	}
+	solution, ok := c.Execute1(clipper.CtUnion, clipper.PftEvenOdd, clipper.PftEvenOdd)
+	if !ok {
+		log.Printf("ERROR importing the outline, the paths could not be joined")
+		return []Path{}
+	}
+	outline := make([]Path, 0)
+	for _, cpath := range solution {
+		outline = append(outline, FromClipperPath(cpath))
+	}
+	return outline
+}
+
+// Move a copy of the paths so the center of their bounds is at 'c'.
+func CenterPaths(paths []Path, c Point) []Path {
+	pts := make(Path, 0)
+	for _, path := range paths {
+		pts = append(pts, path...)
+	}
+	if len(pts) == 0 {
+		return paths
+	}
+	b := pts.Bounds()
+	shift := Point{c.X - (b.Xmin+b.Xmax)/2, c.Y - (b.Ymin+b.Ymax)/2}
+	centered := make([]Path, 0)
+	for _, path := range paths {
+		moved := path.Copy()
+		moved.Rel(shift)
+		centered = append(centered, moved)
+	}
+	return centered
+}
+
+// Get the imported case outline centered on the layout.
+func (k *KAD) ImportOutline() []Path {
+	outline := k.OutlineImport.Paths()
+	if len(outline) == 0 {
+		log.Printf("ERROR importing the case outline: %s", k.Hash)
+		return outline
+	}
+	return CenterPaths(outline, k.LayoutCenter)
+}
+
+// Get the closed shapes (path, polygon, rect, circle and ellipse) of an svg document.
+// If the input is not a document, it is treated as the data of a single path.
+// The transforms of the shapes and their groups are applied, and the shapes which are not drawn (in 'defs',
+// 'clipPath', 'mask', 'marker', 'pattern' and 'symbol') are skipped.
+// When the width and height of the document are in mm, cm, in, pt or pc, the shapes are converted to mm
+// through the viewBox (or from px without one). A document sized in px or without a size keeps its user units,
+// which the scale of the import converts to mm.
+func ParseSvgOutline(input string) []Path {
+	if !strings.Contains(input, "<") {
+		return ParseSvgPath(input)
+	}
+	paths := make([]Path, 0)
+	decoder := xml.NewDecoder(strings.NewReader(input))
+	decoder.Strict = false
+	transforms := []SvgMatrix{SVG_IDENTITY}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		if _, ok := token.(xml.EndElement); ok && len(transforms) > 1 {
+			transforms = transforms[:len(transforms)-1]
+			continue
+		}
+		el, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		attr := func(name string) string {
+			for _, a := range el.Attr {
+				if a.Name.Local == name {
+					return a.Value
+				}
+			}
+			return ""
+		}
+		num := func(name string) float64 {
+			v, _ := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(attr(name)), "px"), 64)
+			return v
+		}
+		switch el.Name.Local {
+		case "defs", "clipPath", "mask", "marker", "pattern", "symbol":
+			decoder.Skip()
+			continue
+		case "use":
+			log.Printf("ERROR importing svg, 'use' elements are not supported and are skipped")
+		}
+		m := transforms[len(transforms)-1]
+		if el.Name.Local == "svg" && len(transforms) == 1 {
+			m = SvgViewport(attr("width"), attr("height"), attr("viewBox"))
+		}
+		m = m.Multiply(ParseSvgTransform(attr("transform")))
+		transforms = append(transforms, m)
+
+		shapes := make([]Path, 0)
+		switch el.Name.Local {
+		case "path":
+			shapes = ParseSvgPath(attr("d"))
+		case "polygon", "polyline":
+			nums := SvgNumbers(attr("points"))
+			path := make(Path, 0)
+			for i := 0; i+1 < len(nums); i += 2 {
+				path = append(path, Point{nums[i], nums[i+1]})
+			}
+			if len(path) > 2 {
+				shapes = append(shapes, path)
+			}
+		case "rect":
+			r := math.Max(num("rx"), num("ry"))
+			w, h := num("width"), num("height")
+			r = math.Min(r, math.Min(w, h)/2-.001)
+			segments := 20
+			if r <= 0 {
+				r, segments = 0, 0
+			}
+			shapes = append(shapes, RoundRectanglePolygon(num("x")+w/2, num("y")+h/2, w, h, r, segments))
+		case "circle":
+			shapes = append(shapes, EllipsePath(num("cx"), num("cy"), num("r"), num("r")))
+		case "ellipse":
+			shapes = append(shapes, EllipsePath(num("cx"), num("cy"), num("rx"), num("ry")))
+		}
+		for _, shape := range shapes {
+			paths = append(paths, m.Apply(shape))
+		}
+	}
+	return paths
+}
+
+// An svg transform matrix [a b c d e f], which maps (x, y) to (a*x + c*y + e, b*x + d*y + f).
+type SvgMatrix [6]float64
+
+var SVG_IDENTITY = SvgMatrix{1, 0, 0, 1, 0, 0}
+
+// Get the transform which applies 'n' and then this transform.
+func (m SvgMatrix) Multiply(n SvgMatrix) SvgMatrix {
+	return SvgMatrix{
+		m[0]*n[0] + m[2]*n[1],
+		m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3],
+		m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4],
+		m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+// Get a transformed copy of a path.
+func (m SvgMatrix) Apply(path Path) Path {
+	moved := make(Path, len(path))
+	for i, pt := range path {
+		moved[i] = Point{m[0]*pt.X + m[2]*pt.Y + m[4], m[1]*pt.X + m[3]*pt.Y + m[5]}
+	}
+	return moved
+}
+
+// Parse an svg transform attribute, the transforms in the list are applied from right to left.
+func ParseSvgTransform(s string) SvgMatrix {
+	m := SVG_IDENTITY
+	for _, part := range strings.Split(s, ")") {
+		fields := strings.SplitN(part, "(", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		name := strings.Trim(strings.TrimSpace(fields[0]), ",")
+		v := SvgNumbers(fields[1])
+		arg := func(i int, dflt float64) float64 {
+			if i < len(v) {
+				return v[i]
+			}
+			return dflt
+		}
+		var t SvgMatrix
+		switch name {
+		case "matrix":
+			if len(v) != 6 {
+				log.Printf("ERROR importing svg, invalid transform: %s", part+")")
+				continue
+			}
+			copy(t[:], v)
+		case "translate":
+			t = SvgMatrix{1, 0, 0, 1, arg(0, 0), arg(1, 0)}
+		case "scale":
+			t = SvgMatrix{arg(0, 1), 0, 0, arg(1, arg(0, 1)), 0, 0}
+		case "rotate":
+			a := radians(arg(0, 0))
+			cx, cy := arg(1, 0), arg(2, 0)
+			cos, sin := math.Cos(a), math.Sin(a)
+			t = SvgMatrix{cos, sin, -sin, cos, cx - cos*cx + sin*cy, cy - sin*cx - cos*cy}
+		case "skewX":
+			t = SvgMatrix{1, 0, math.Tan(radians(arg(0, 0))), 1, 0, 0}
+		case "skewY":
+			t = SvgMatrix{1, math.Tan(radians(arg(0, 0))), 0, 1, 0, 0}
+		default:
+			log.Printf("ERROR importing svg, unknown transform: %s", part+")")
+			continue
+		}
+		m = m.Multiply(t)
+	}
+	return m
+}
+
+// Get the transform from the user units of an svg document to mm, based on the size and viewBox of the document.
+// The viewBox is stretched to the size of the document, so 'preserveAspectRatio' is not taken into account.
+func SvgViewport(width, height, view_box string) SvgMatrix {
+	w, w_mm := SvgLength(width)
+	h, h_mm := SvgLength(height)
+	if !w_mm || !h_mm {
+		return SVG_IDENTITY // the user units are left for the scale of the import to convert
+	}
+	vb := SvgNumbers(view_box)
+	if len(vb) != 4 || vb[2] <= 0 || vb[3] <= 0 {
+		px := MM_PER_INCH / PROFILE_DPI // without a viewBox the user units are px
+		return SvgMatrix{px, 0, 0, px, 0, 0}
+	}
+	sx, sy := w/vb[2], h/vb[3]
+	return SvgMatrix{sx, 0, 0, sy, -vb[0] * sx, -vb[1] * sy}
+}
+
+// Get an svg length in mm, and if it has a physical unit which can be converted to mm.
+func SvgLength(s string) (float64, bool) {
+	units := map[string]float64{"mm": 1, "cm": 10, "in": MM_PER_INCH, "pt": MM_PER_INCH / 72, "pc": MM_PER_INCH / 6}
+	s = strings.TrimSpace(s)
+	for unit, mm := range units {
+		if strings.HasSuffix(s, unit) {
+			v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, unit)), 64)
+			return v * mm, err == nil && v > 0
+		}
+	}
+	return 0, false
+}
+
+// Get all of the numbers in a list of svg values.
+func SvgNumbers(s string) []float64 {
+	nums := make([]float64, 0)
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r' }) {
+		if v, err := strconv.ParseFloat(f, 64); err == nil {
+			nums = append(nums, v)
+		}
+	}
+	return nums
+}
+
+// Get an ellipse as a polygon with its points on the true curve.
+func EllipsePath(cx, cy, rx, ry float64) Path {
+	n := int(math.Ceil(360 / IMPORT_ARC_STEP))
+	path := make(Path, n)
+	for i := range path {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		path[i] = Point{cx + rx*math.Cos(a), cy + ry*math.Sin(a)}
+	}
+	return path
+}
+
+// Parse svg path data into closed paths, the curves and arcs are split into straight segments.
+// Every sub path is treated as closed, even if it is missing the 'z' command.
+func ParseSvgPath(d string) []Path {
+	paths := make([]Path, 0)
+	i := 0
+	skip := func() {
+		for i < len(d) && strings.ContainsRune(" ,\t\n\r", rune(d[i])) {
+			i++
+		}
+	}
+	// read a number, a flag of an arc is a single digit which may not be separated from the next number
+	number := func(flag bool) (float64, bool) {
+		skip()
+		if i >= len(d) {
+			return 0, false
+		}
+		if flag {
+			if d[i] == '0' || d[i] == '1' {
+				i++
+				return float64(d[i-1] - '0'), true
+			}
+			return 0, false
+		}
+		start := i
+		if d[i] == '+' || d[i] == '-' {
+			i++
+		}
+		dot, exp := false, false
+		for ; i < len(d); i++ {
+			c := d[i]
+			if c >= '0' && c <= '9' {
+				continue
+			}
+			if c == '.' && !dot && !exp {
+				dot = true
+				continue
+			}
+			if (c == 'e' || c == 'E') && !exp && i > start {
+				exp = true
+				if i+1 < len(d) && (d[i+1] == '+' || d[i+1] == '-') {
+					i++
+				}
+				continue
+			}
+			break
+		}
+		v, err := strconv.ParseFloat(d[start:i], 64)
+		if err != nil {
+			i = start
+			return 0, false
+		}
+		return v, true
+	}
+
+	var path Path
+	var cur, start, ctrl Point
+	var prev byte
+	finish := func() {
+		if len(path) > 1 && path[0] == path[len(path)-1] {
+			path = path[:len(path)-1]
+		}
+		if len(path) > 2 {
+			paths = append(paths, path)
+		}
+		path = nil
+	}
+	var cmd byte
+	for {
+		skip()
+		if i >= len(d) {
+			break
+		}
+		if c := d[i]; (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+			cmd = c
+			i++
+		} else if cmd == 0 {
+			log.Printf("ERROR parsing svg path data at: %d", i)
+			break
+		}
+		rel := cmd >= 'a'
+		abs := func(x, y float64) Point {
+			if rel {
+				return Point{cur.X + x, cur.Y + y}
+			}
+			return Point{x, y}
+		}
+		params := map[byte]int{'m': 2, 'l': 2, 'h': 1, 'v': 1, 'c': 6, 's': 4, 'q': 4, 't': 2, 'a': 7, 'z': 0}
+		lower := cmd | 0x20
+		count, known := params[lower]
+		if !known {
+			log.Printf("ERROR unknown svg path command: %c", cmd)
+			break
+		}
+		v := make([]float64, count)
+		valid := true
+		for j := range v {
+			if v[j], valid = number(lower == 'a' && (j == 3 || j == 4)); !valid {
+				break
+			}
+		}
+		if !valid {
+			log.Printf("ERROR parsing svg path data at: %d", i)
+			break
+		}
+		if path == nil && lower != 'm' && lower != 'z' { // drawing continues after a 'z' from the start point
+			path = Path{cur}
+		}
+		switch lower {
+		case 'm':
+			finish()
+			cur = abs(v[0], v[1])
+			start = cur
+			path = Path{cur}
+			cmd = 'L' | (cmd & 0x20) // extra pairs after a move are lines
+		case 'l':
+			cur = abs(v[0], v[1])
+			path = append(path, cur)
+		case 'h':
+			if rel {
+				cur.X += v[0]
+			} else {
+				cur.X = v[0]
+			}
+			path = append(path, cur)
+		case 'v':
+			if rel {
+				cur.Y += v[0]
+			} else {
+				cur.Y = v[0]
+			}
+			path = append(path, cur)
+		case 'c', 's':
+			c1 := cur
+			if lower == 's' {
+				if p := prev | 0x20; p == 'c' || p == 's' {
+					c1 = Point{2*cur.X - ctrl.X, 2*cur.Y - ctrl.Y}
+				}
+				v = append([]float64{0, 0}, v...)
+			} else {
+				c1 = abs(v[0], v[1])
+			}
+			c2, end := abs(v[2], v[3]), abs(v[4], v[5])
+			for j := 1; j <= IMPORT_CURVE_SEGMENTS; j++ {
+				t := float64(j) / IMPORT_CURVE_SEGMENTS
+				a, b, c, e := (1-t)*(1-t)*(1-t), 3*(1-t)*(1-t)*t, 3*(1-t)*t*t, t*t*t
+				path = append(path, Point{
+					a*cur.X + b*c1.X + c*c2.X + e*end.X,
+					a*cur.Y + b*c1.Y + c*c2.Y + e*end.Y})
+			}
+			ctrl, cur = c2, end
+		case 'q', 't':
+			c1 := cur
+			if lower == 't' {
+				if p := prev | 0x20; p == 'q' || p == 't' {
+					c1 = Point{2*cur.X - ctrl.X, 2*cur.Y - ctrl.Y}
+				}
+				v = append([]float64{0, 0}, v...)
+			} else {
+				c1 = abs(v[0], v[1])
+			}
+			end := abs(v[2], v[3])
+			for j := 1; j <= IMPORT_CURVE_SEGMENTS; j++ {
+				t := float64(j) / IMPORT_CURVE_SEGMENTS
+				a, b, c := (1-t)*(1-t), 2*(1-t)*t, t*t
+				path = append(path, Point{a*cur.X + b*c1.X + c*end.X, a*cur.Y + b*c1.Y + c*end.Y})
+			}
+			ctrl, cur = c1, end
+		case 'a':
+			end := abs(v[5], v[6])
+			path = append(path, SvgArcPoints(cur, end, v[0], v[1], v[2], v[3] != 0, v[4] != 0)...)
+			cur = end
+		case 'z':
+			finish()
+			cur = start
+			cmd = 0 // only a new command can follow
+		}
+		prev = cmd
+	}
+	finish()
+	return paths
+}
+
+// Get the points along an svg arc from 'start' to 'end', not including the start point.
+// The center of the arc is found as described in the 'implementation notes' of the svg spec.
+func SvgArcPoints(start, end Point, rx, ry, rotation float64, large, sweep bool) Path {
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 || start == end {
+		return Path{end}
+	}
+	phi := radians(rotation)
+	cos, sin := math.Cos(phi), math.Sin(phi)
+	dx, dy := (start.X-end.X)/2, (start.Y-end.Y)/2
+	x1, y1 := cos*dx+sin*dy, -sin*dx+cos*dy
+	if l := x1*x1/(rx*rx) + y1*y1/(ry*ry); l > 1 { // the radii are too small, so scale them up
+		rx, ry = rx*math.Sqrt(l), ry*math.Sqrt(l)
+	}
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	f := math.Sqrt(math.Max(num/den, 0))
+	if large == sweep {
+		f = -f
+	}
+	cx1, cy1 := f*rx*y1/ry, -f*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (start.X+end.X)/2
+	cy := sin*cx1 + cos*cy1 + (start.Y+end.Y)/2
+	angle := func(ux, uy float64) float64 {
+		return math.Atan2(uy, ux)
+	}
+	a1 := angle((x1-cx1)/rx, (y1-cy1)/ry)
+	da := angle((-x1-cx1)/rx, (-y1-cy1)/ry) - a1
+	if sweep && da < 0 {
+		da += 2 * math.Pi
+	} else if !sweep && da > 0 {
+		da -= 2 * math.Pi
+	}
+	n := int(math.Ceil(math.Abs(da) / radians(IMPORT_ARC_STEP)))
+	pts := make(Path, 0)
+	for j := 1; j < n; j++ {
+		a := a1 + da*float64(j)/float64(n)
+		x, y := rx*math.Cos(a), ry*math.Sin(a)
+		pts = append(pts, Point{cos*x - sin*y + cx, sin*x + cos*y + cy})
+	}
+	return append(pts, end)
+}
+
+// Get the closed polylines (lwpolyline and polyline) and circles of a dxf document.
+// The bulges of the polylines are split into straight segments and the y axis is flipped to point down.
+func ParseDxfOutline(input string) []Path {
+	lines := strings.Split(strings.Replace(input, "\r\n", "\n", -1), "\n")
+	type pair struct {
+		Code  int
+		Value string
+	}
+	pairs := make([]pair, 0)
+	for i := 0; i+1 < len(lines); i += 2 {
+		code, err := strconv.Atoi(strings.TrimSpace(lines[i]))
+		if err != nil {
+			log.Printf("ERROR parsing dxf group code on line: %d", i+1)
+			return []Path{}
+		}
+		pairs = append(pairs, pair{code, strings.TrimSpace(lines[i+1])})
+	}
+
+	paths := make([]Path, 0)
+	var verts Path
+	var bulges []float64
+	closed, in_poly := false, false
+	add := func() {
+		if !closed && len(verts) > 1 && verts[0] == verts[len(verts)-1] {
+			verts, bulges = verts[:len(verts)-1], bulges[:len(bulges)-1]
+			closed = true
+		}
+		if !closed {
+			if len(verts) > 0 {
+				log.Printf("WARNING skipping an open dxf polyline with %d vertices", len(verts))
+			}
+			return
+		}
+		path := make(Path, 0)
+		for i, v := range verts {
+			next := verts[(i+1)%len(verts)]
+			path = append(path, v)
+			if bulges[i] != 0 {
+				path = append(path, BulgePoints(v, next, bulges[i])...)
+			}
+		}
+		if len(path) > 2 {
+			paths = append(paths, path)
+		}
+	}
+
+	entity := ""
+	var circle [3]float64
+	for _, p := range pairs {
+		f, _ := strconv.ParseFloat(p.Value, 64)
+		if p.Code == 0 {
+			switch {
+			case entity == "LWPOLYLINE":
+				add()
+			case entity == "CIRCLE":
+				paths = append(paths, EllipsePath(circle[0], circle[1], circle[2], circle[2]))
+			case p.Value == "SEQEND" && in_poly:
+				add()
+				in_poly = false
+			}
+			entity = p.Value
+			switch entity {
+			case "CIRCLE":
+				circle = [3]float64{}
+			case "LWPOLYLINE", "POLYLINE":
+				verts, bulges, closed = Path{}, []float64{}, false
+				in_poly = entity == "POLYLINE"
+			case "VERTEX":
+				if in_poly {
+					verts = append(verts, Point{})
+					bulges = append(bulges, 0)
+				}
+			}
+			continue
+		}
+		switch entity {
+		case "LWPOLYLINE", "VERTEX":
+			if entity == "VERTEX" && !in_poly {
+				continue
+			}
+			switch p.Code {
+			case 10:
+				if entity == "LWPOLYLINE" {
+					verts = append(verts, Point{})
+					bulges = append(bulges, 0)
+				}
+				if len(verts) > 0 {
+					verts[len(verts)-1].X = f
+				}
+			case 20:
+				if len(verts) > 0 {
+					verts[len(verts)-1].Y = -f
+				}
+			case 42:
+				if len(bulges) > 0 {
+					bulges[len(bulges)-1] = -f // the y axis is flipped so the bulge changes direction
+				}
+			case 70:
+				if entity == "LWPOLYLINE" {
+					flags, _ := strconv.Atoi(p.Value)
+					closed = flags&1 == 1
+				}
+			}
+		case "POLYLINE":
+			if p.Code == 70 {
+				flags, _ := strconv.Atoi(p.Value)
+				closed = flags&1 == 1
+			}
+		case "CIRCLE":
+			switch p.Code {
+			case 10:
+				circle[0] = f
+			case 20:
+				circle[1] = -f
+			case 40:
+				circle[2] = f
+			}
+		}
+	}
+	return paths
+}
+
+// Get the points along the arc of a polyline bulge from 'start' to 'end', not including either end.
+// The bulge is the tangent of a quarter of the angle of the arc, positive when it turns counter clockwise.
+func BulgePoints(start, end Point, bulge float64) Path {
+	angle := 4 * math.Atan(bulge)
+	chord := math.Hypot(end.X-start.X, end.Y-start.Y)
+	if chord == 0 {
+		return Path{}
+	}
+	r := chord / (2 * math.Sin(math.Abs(angle)/2))
+	// the center is on the perpendicular bisector of the chord
+	mid := Point{(start.X + end.X) / 2, (start.Y + end.Y) / 2}
+	dist := r * math.Cos(math.Abs(angle)/2)
+	nx, ny := -(end.Y-start.Y)/chord, (end.X-start.X)/chord
+	if bulge < 0 {
+		nx, ny = -nx, -ny
+	}
+	center := Point{mid.X + nx*dist, mid.Y + ny*dist}
+	a := math.Atan2(start.Y-center.Y, start.X-center.X)
+	n := int(math.Ceil(math.Abs(angle) / radians(IMPORT_ARC_STEP)))
+	pts := make(Path, 0)
+	for j := 1; j < n; j++ {
+		t := a + angle*float64(j)/float64(n)
+		pts = append(pts, Point{center.X + r*math.Cos(t), center.Y + r*math.Sin(t)})
+	}
+	return pts
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -21,6 +23,7 @@ const (
 	OVERLAP             = 0.001
 	STORE_SWIFT         = "swift"
 	STORE_LOCAL         = "local"
+	LINE_COLOR          = "black" // default stroke color of the cuts
 )
 
 type KAD struct {
@@ -32,8 +35,24 @@ type KAD struct {
 	Height         float64
 	LayoutCenter   Point
 	CaseCenter     Point
-	Fillet         float64 `json:"fillet"`
-	Kerf           float64 `json:"kerf"`
+	Fillet         float64      `json:"fillet"`
+	Outline        string       `json:"outline"`
+	OutlineImport  Import       `json:"outline-import"`
+	TrueArcs       bool         `json:"true-arcs"`
+	Fabrication    Fabrication  `json:"fabrication"`
+	DesignRules    DesignRules  `json:"design-rules"`
+	Material       Material     `json:"material"`
+	Nesting        Nesting      `json:"nesting"`
+	Segmentation   Segmentation `json:"segmentation"`
+	FlexCuts       FlexCuts     `json:"flex-cuts"`
+	Engraving      Engraving    `json:"engraving"`
+	Pdf            PdfOptions   `json:"pdf"`
+	Gcode          GcodeOptions `json:"gcode"`
+	Model          ModelOptions `json:"model"`
+	Kicad          KicadOptions `json:"kicad"`
+	CombinedSvg    CombinedSvg  `json:"combined-svg"`
+	Preview        PngPreview   `json:"preview"`
+	Kerf           float64      `json:"kerf"`
 	Xoff           float64
 	TopPad         float64         `json:"top-padding"`
 	LeftPad        float64         `json:"left-padding"`
@@ -44,9 +63,14 @@ type KAD struct {
 	SwitchType     int             `json:"switch-type"`
 	StabType       int             `json:"stab-type"`
 	Case           Case            `json:"case"`
+	WristRest      WristRest       `json:"wrist-rest"`
+	Profile        ExportProfile   `json:"export-profile"`
 	CustomPolygons []CustomPolygon `json:"custom"`
 	RawLayout      []interface{}   `json:"layout"`
 	Layout         [][]Key         `json:"-"` // ignore in 'unmarshal'
+	MountHoles     Path
+	Interior       []Path   // open area inside the case edge, as it was cut out of the middle layers
+	Arcs           ArcIndex // arcs of the curves drawn in the frame of the case
 	Svgs           map[string]SvgWrapper
 	Layers         map[string]*Layer
 	SvgStyle       string
@@ -66,14 +90,38 @@ type Result struct {
 	Plates    []string                  `json:"plates"`
 	Formats   []string                  `json:"formats"`
 	Details   map[string]*ResultDetails `json:"details"`
+	Warnings  []Warning                 `json:"warnings"`
+	Weight    float64                   `json:"weight"`   // estimated weight of all the plates in g
+	Cost      float64                   `json:"cost"`     // estimated cost of all the plates
+	Sheets    []string                  `json:"sheets"`   // stock sheets with the plates nested on them, only cut as svg and dxf
+	Models    []Export                  `json:"models"`   // 3D models of the layers stacked into the case
+	Pcb       []Export                  `json:"pcb"`      // board files with the outline and the footprints placed
+	Combined  []Export                  `json:"combined"` // all the plates drawn in one file
+	Previews  []Export                  `json:"previews"` // png previews of the layers stacked into the case
 }
 
 type ResultDetails struct {
-	Name    string   `json:"name"`
-	Width   float64  `json:"width"`
-	Height  float64  `json:"height"`
-	Area    float64  `json:"area"`
-	Exports []Export `json:"exports"`
+	Name        string      `json:"name"`
+	Width       float64     `json:"width"`
+	Height      float64     `json:"height"`
+	Area        float64     `json:"area"`
+	CutLength   float64     `json:"cut_length"`            // total length of the cut paths in mm
+	Pierces     int         `json:"pierces"`               // number of closed cut paths
+	SheetWidth  float64     `json:"sheet_width"`           // width of the sheet needed for the plate in mm
+	SheetHeight float64     `json:"sheet_height"`          // height of the sheet needed for the plate in mm
+	Weight      float64     `json:"weight"`                // estimated weight of the plate in g
+	Cost        float64     `json:"cost"`                  // estimated cost of the plate
+	Utilisation float64     `json:"utilisation,omitempty"` // percent of the sheet covered by plates
+	Parts       []Placement `json:"parts,omitempty"`       // plates nested on the sheet
+	Exports     []Export    `json:"exports"`
+}
+
+type Warning struct {
+	Code     string `json:"code"`
+	Layer    string `json:"layer"`
+	Message  string `json:"message"`
+	Location Point  `json:"location"`
+	Keys     []int  `json:"keys,omitempty"`
 }
 
 type Export struct {
@@ -88,6 +136,9 @@ type SvgWrapper struct {
 type Layer struct {
 	CutPolys  []Path
 	KeepPolys []Path
+	EdgeCuts  []Path    // cuts which are meant to open up the edge of the layer
+	Labels    []Label   // text engraved on the layer
+	Arcs      *ArcIndex // arcs of a layer which is not drawn in the frame of the case
 	Width     float64
 	Height    float64
 }
@@ -140,13 +191,19 @@ func New() *KAD {
 		Svgs:       make(map[string]SvgWrapper),
 		Layers:     make(map[string]*Layer),
 		SvgStyle:   "fill:none",
-		LineColor:  "black",
+		LineColor:  LINE_COLOR,
 		LineWeight: 0.05,
 		Result: Result{
 			HasLayers: false,
 			Plates:    []string{},
 			Formats:   []string{"svg"},
 			Details:   make(map[string]*ResultDetails),
+			Warnings:  []Warning{},
+			Sheets:    []string{},
+			Models:    []Export{},
+			Pcb:       []Export{},
+			Combined:  []Export{},
+			Previews:  []Export{},
 		},
 	}
 
@@ -160,6 +217,21 @@ func New() *KAD {
 // Draw the SVGs needed for this layout.
 func (k *KAD) Draw() error {
 	k.Kerf = k.Kerf / 2 // set kerf to be half of the real kerf as we are working from the center of the kerf
+	k.Arcs.Kerf = k.Kerf
+	k.ApplyProfile()
+
+	// use different colors for the cuts and the engraving
+	if k.Engraving.Enabled() {
+		if k.Engraving.Color == "" {
+			k.Engraving.Color = ENGRAVE_COLOR
+		}
+		if k.Engraving.CutColor == "" {
+			k.Engraving.CutColor = ENGRAVE_CUT_COLOR
+		}
+		if k.LineColor == LINE_COLOR { // keep a line color which was asked for
+			k.LineColor = k.Engraving.CutColor
+		}
+	}
 	k.SvgStyle = fmt.Sprintf("%s;stroke-width:%fmm;stroke:%s", k.SvgStyle, k.LineWeight, k.LineColor)
 
 	k.InitCaseLayers()
@@ -171,9 +243,19 @@ func (k *KAD) Draw() error {
 	}
 	k.DrawLayout()
 	k.UpdateLayerDimensions()
-	k.DrawHoles()
+	k.DrawConnectors()
+	k.DrawBottomFeatures()
+	k.DrawHoles() // after the connectors and the bottom features so the holes can keep clear of them
+	k.DrawFlexCuts()
+	k.CheckKeys()
 	k.FinalizePolygons()
+	k.DrawEngraving()
 	k.FinalizeLayerDimensions()
+	k.DrawWristRest()
+	k.SegmentPlates()
+	k.CheckDesignRules()
+	k.UpdateMetrics()
+	k.NestPlates()
 	if err := k.DrawOutputFiles(); err != nil {
 		log.Printf("ERROR drawing SVGs, exiting early...\n%s", err.Error())
 		return err
@@ -263,10 +345,14 @@ func (k *KAD) ParseLayout() error {
 				row_layout = append(row_layout, *key)
 				key_map = true // this will ignore the next non-map key
 			} else {
+				label, _ := raw_layout[row][k].(string)
 				if !key_map { // only handle if it was not already handled as a key_map, set to defaults
 					key.Width = 1
 					key.Height = 1
+					key.Label = LegendText(label)
 					row_layout = append(row_layout, *key)
+				} else if len(row_layout) > 0 { // the label of the key described by the map
+					row_layout[len(row_layout)-1].Label = LegendText(label)
 				}
 				key_map = false
 			}
@@ -282,7 +368,7 @@ func (k *KAD) DrawLayout() {
 	prev_width := 0.0
 	prev_y_off := 0.0
 	c := &Key{}
-	p := &Point{k.DMZ + k.Kerf + k.LeftPad, k.DMZ + k.Kerf + k.TopPad}
+	p := &Point{k.DMZ + k.LeftPad, k.DMZ + k.TopPad}
 	for ri, row := range k.Layout {
 		for ki, key := range row {
 			// handle absolute positioned keys and rotated clusters
@@ -306,11 +392,11 @@ func (k *KAD) DrawLayout() {
 					p.Y += c.Yabs * k.U1
 				}
 			case ki == 0: // change rows
-				p.X = k.DMZ + k.LeftPad + k.Kerf + key.Xrel*k.U1 + key.Width*k.U1/2
+				p.X = k.DMZ + k.LeftPad + key.Xrel*k.U1 + key.Width*k.U1/2
 				switch {
 				case key.Xabs != 0 || key.Yabs != 0: // the first row in a cluster
 					p.X += c.Xabs * k.U1
-					p.Y = k.DMZ + k.TopPad + k.Kerf + c.Yabs*k.U1 + key.Yrel*k.U1 + k.U1/2
+					p.Y = k.DMZ + k.TopPad + c.Yabs*k.U1 + key.Yrel*k.U1 + k.U1/2
 				case c.Xabs != 0 || c.Yabs != 0: // a cluster row, but not the first cluster row
 					p.X += c.Xabs * k.U1
 					p.Y += key.Yrel*k.U1 + k.U1
@@ -334,8 +420,11 @@ func (k *KAD) DrawLayout() {
 			} else {
 				init = false
 			}
+			start := len(k.Layers[SWITCHLAYER].CutPolys)
 			key.Draw(k, *p, *c, init)
+			key.Cutouts = append([]Path{}, k.Layers[SWITCHLAYER].CutPolys[start:]...)
 			//k.draw_switch(*p, key, *c, init)
+			k.Layout[ri][ki] = key // keep the resolved key details
 			prev_width = key.Width
 		}
 	}
@@ -343,8 +432,8 @@ func (k *KAD) DrawLayout() {
 
 // update the dimensions of the kad based on what has been added
 func (k *KAD) UpdateLayerDimensions() {
-	k.Width = k.Bounds.Xmax + k.RightPad + k.Kerf - k.DMZ
-	k.Height = k.Bounds.Ymax + k.BottomPad + k.Kerf - k.DMZ
+	k.Width = k.Bounds.Xmax + k.RightPad - k.DMZ
+	k.Height = k.Bounds.Ymax + k.BottomPad - k.DMZ
 	k.CaseCenter = Point{k.DMZ + (k.Width / 2), k.DMZ + (k.Height / 2)}
 	k.LayoutCenter = Point{
 		(k.Bounds.Xmax-k.Bounds.Xmin)/2 + k.Bounds.Xmin,
@@ -355,16 +444,16 @@ func (k *KAD) UpdateLayerDimensions() {
 		switch {
 		case (layer == OPENLAYER || layer == CLOSEDLAYER) && k.TopPad < 0 && k.BottomPad < 0:
 			if k.Case.EdgeWidth > 0 {
-				k.Result.Details[layer].Width = 2*k.Case.EdgeWidth + 4*k.Kerf + 10 // layout the two parts 10mm apart
+				k.Result.Details[layer].Width = 2*k.Case.EdgeWidth + 10 // layout the two parts 10mm apart
 			} else {
-				k.Result.Details[layer].Width = k.LeftPad + k.RightPad + 4*k.Kerf + 10 // layout the two parts 10mm apart
+				k.Result.Details[layer].Width = k.LeftPad + k.RightPad + 10 // layout the two parts 10mm apart
 			}
 			k.Result.Details[layer].Height = k.Height
 		case (layer == OPENLAYER || layer == CLOSEDLAYER) && k.LeftPad < 0 && k.RightPad < 0:
 			if k.Case.EdgeWidth > 0 {
-				k.Result.Details[layer].Height = 2*k.Case.EdgeWidth + 4*k.Kerf + 10 // layout the two parts 10mm apart
+				k.Result.Details[layer].Height = 2*k.Case.EdgeWidth + 10 // layout the two parts 10mm apart
 			} else {
-				k.Result.Details[layer].Height = k.TopPad + k.BottomPad + 4*k.Kerf + 10 // layout the two parts 10mm apart
+				k.Result.Details[layer].Height = k.TopPad + k.BottomPad + 10 // layout the two parts 10mm apart
 			}
 			k.Result.Details[layer].Width = k.Width
 		default:
@@ -385,10 +474,11 @@ func (k *KAD) FinalizeLayerDimensions() {
 
 	// determine the offset from the new bounds
 	offset := &Point{0.0, 0.0}
-	if k.Bounds.Xmin-k.DMZ < 0 {
+	fitted := k.Outline != OUTLINE_RECT // the 'layout' and 'import' outlines may not start at the DMZ
+	if k.Bounds.Xmin-k.DMZ < 0 || fitted && k.Bounds.Xmin-k.DMZ != 0 {
 		offset.X = -(k.Bounds.Xmin - k.DMZ)
 	}
-	if k.Bounds.Ymin-k.DMZ < 0 {
+	if k.Bounds.Ymin-k.DMZ < 0 || fitted && k.Bounds.Ymin-k.DMZ != 0 {
 		offset.Y = -(k.Bounds.Ymin - k.DMZ)
 	}
 
@@ -399,6 +489,22 @@ func (k *KAD) FinalizeLayerDimensions() {
 	k.CaseCenter.Y += offset.Y
 	k.LayoutCenter.X += offset.X
 	k.LayoutCenter.Y += offset.Y
+	for i := range k.Result.Warnings {
+		k.Result.Warnings[i].Location.X += offset.X
+		k.Result.Warnings[i].Location.Y += offset.Y
+	}
+	for r := range k.Layout {
+		for c := range k.Layout[r] {
+			k.Layout[r][c].Center.X += offset.X
+			k.Layout[r][c].Center.Y += offset.Y
+			k.Layout[r][c].Bounds.Rel(*offset)
+		}
+	}
+	k.MountHoles.Rel(*offset)
+	for p := range k.Interior {
+		k.Interior[p].Rel(*offset)
+	}
+	k.Arcs.Rel(*offset)
 
 	// shift the points based on the updated dimensions
 	for _, layer := range k.Result.Plates {
@@ -406,6 +512,12 @@ func (k *KAD) FinalizeLayerDimensions() {
 		for p := range k.Layers[layer].KeepPolys {
 			k.Layers[layer].KeepPolys[p].Rel(*offset)
 		}
+		for l := range k.Layers[layer].Labels {
+			k.Layers[layer].Labels[l].At.X += offset.X
+			k.Layers[layer].Labels[l].At.Y += offset.Y
+		}
+		k.Layers[layer].Width = k.Width
+		k.Layers[layer].Height = k.Height
 		// update result sizes
 		switch {
 		case (layer == OPENLAYER || layer == CLOSEDLAYER) && k.TopPad < 0 && k.BottomPad < 0:
@@ -424,33 +536,63 @@ func (k *KAD) DrawOutputFiles() error {
 	_ = os.Mkdir(k.FileDirectory, 0755)
 	for _, layer := range k.Result.Plates {
 		abs_svg, err := filepath.Abs(fmt.Sprintf("%s%s_%s.svg", k.FileDirectory, k.Hash, layer))
-		file, err := os.Create(abs_svg)
-		if err != nil {
-			log.Printf("ERROR Creating export file: %s, %s | %s", k.Hash, layer, err.Error())
+		if err = k.WriteSvg(layer, abs_svg); err != nil {
 			return err
 		}
 
-		k.Svgs[layer] = SvgWrapper{File: file, Svg: svg.New(file)}
-		canvas := k.Svgs[layer].Svg
-		canvas.FloatDecimals = 3
-		canvas.StartviewUnitF(k.Width+2*k.DMZ, k.Height+2*k.DMZ, k.UOM, 0, 0, k.Width+2*k.DMZ, k.Height+2*k.DMZ)
-
-		// draw the elements
-		if len(k.Layers[layer].KeepPolys) > 0 { // draw polygons
-			xs, ys := make([]float64, 0), make([]float64, 0)
-			for _, poly := range k.Layers[layer].KeepPolys {
-				if len(poly) > 0 {
-					xs, ys = poly.SplitOnAxis()
-					canvas.PolygonF(xs, ys, k.SvgStyle)
+		// create other file formats
+		native_dxf := k.TrueArcs && in_strings("dxf", k.Result.Formats)
+		if native_dxf {
+			abs_dxf := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "dxf")
+			if err = k.WriteDxf(layer, abs_dxf); err != nil {
+				log.Printf("ERROR: could not create DXF file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		if in_strings("pdf", k.Result.Formats) {
+			abs_pdf := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "pdf")
+			if err = k.WritePdf(layer, abs_pdf); err != nil {
+				log.Printf("ERROR: could not create PDF file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		for _, ext := range []string{"stl", "3mf"} {
+			if in_strings(ext, k.Result.Formats) {
+				abs_model := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), ext)
+				if err = k.WriteLayerModel(layer, abs_model, ext); err != nil {
+					log.Printf("ERROR: could not create %s file for: %s, %s | %s", ext, k.Hash, layer, err.Error())
 				}
 			}
 		}
-
-		canvas.End()
-		file.Close() // close written svg
-
-		// create other file formats
-		if in_strings("dxf", k.Result.Formats) || in_strings("eps", k.Result.Formats) {
+		if in_strings("png", k.Result.Formats) {
+			abs_png := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "png")
+			if err = k.WritePng(abs_png, []string{layer}, 1); err != nil {
+				log.Printf("ERROR: could not create PNG file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		if in_strings("geojson", k.Result.Formats) {
+			abs_geojson := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "geojson")
+			if err = k.WriteGeoJson(layer, abs_geojson); err != nil {
+				log.Printf("ERROR: could not create GeoJSON file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		if in_strings("scad", k.Result.Formats) {
+			abs_scad := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "scad")
+			if err = k.WriteScad(layer, abs_scad); err != nil {
+				log.Printf("ERROR: could not create OpenSCAD file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		if in_strings("hpgl", k.Result.Formats) {
+			abs_hpgl := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "hpgl")
+			if err = k.WriteHpgl(layer, abs_hpgl); err != nil {
+				log.Printf("ERROR: could not create HPGL file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		if in_strings("gcode", k.Result.Formats) {
+			abs_gcode := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "gcode")
+			if err = k.WriteGcode(layer, abs_gcode); err != nil {
+				log.Printf("ERROR: could not create G-code file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+		if (in_strings("dxf", k.Result.Formats) && !native_dxf) || in_strings("eps", k.Result.Formats) {
 			err = exec.Command("inkscape", "--export-type=eps", abs_svg).Run()
 			if err != nil {
 				log.Printf("ERROR: could not create EPS file for: %s, %s | %s", k.Hash, layer, err.Error())
@@ -458,7 +600,7 @@ func (k *KAD) DrawOutputFiles() error {
 			}
 			log.Println("created eps file")
 
-			if in_strings("dxf", k.Result.Formats) {
+			if in_strings("dxf", k.Result.Formats) && !native_dxf {
 				// inkscape's export-type option automatically creates file extension of "eps".
 				// pstoedit converts "eps" to "dxf".
 				abs_eps := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "eps")
@@ -476,9 +618,116 @@ func (k *KAD) DrawOutputFiles() error {
 			}
 		}
 	}
+
+	// the sheets are only cut, so they only get the cut files
+	for _, layer := range k.Result.Sheets {
+		abs_svg, err := filepath.Abs(fmt.Sprintf("%s%s_%s.svg", k.FileDirectory, k.Hash, layer))
+		if err = k.WriteSvg(layer, abs_svg); err != nil {
+			return err
+		}
+		if in_strings("dxf", k.Result.Formats) {
+			abs_dxf := fmt.Sprintf("%s.%s", strings.TrimSuffix(abs_svg, ".svg"), "dxf")
+			if err = k.WriteDxf(layer, abs_dxf); err != nil {
+				log.Printf("ERROR: could not create DXF file for: %s, %s | %s", k.Hash, layer, err.Error())
+			}
+		}
+	}
+
+	// the 3d models of the case and the board are made from all the layers
+	if abs_base, err := filepath.Abs(k.FileDirectory + k.Hash); err == nil {
+		k.WriteModels(abs_base)
+		k.WriteKicad(abs_base)
+		k.WriteStackPreview(abs_base)
+	}
+	if err := k.DrawCombinedSvg(); err != nil {
+		log.Printf("ERROR: could not create the combined SVG for: %s | %s", k.Hash, err.Error())
+	}
 	return nil
 }
 
+// Write the cuts of a layer, and its engraving, as an svg.
+func (k *KAD) WriteSvg(layer string, abs_svg string) error {
+	file, err := os.Create(abs_svg)
+	if err != nil {
+		log.Printf("ERROR Creating export file: %s, %s | %s", k.Hash, layer, err.Error())
+		return err
+	}
+
+	k.Svgs[layer] = SvgWrapper{File: file, Svg: svg.New(file)}
+	canvas := k.Svgs[layer].Svg
+	canvas.FloatDecimals = 3
+	width, height := k.Layers[layer].Width, k.Layers[layer].Height
+	doc_width, doc_height, unit := k.DocumentSize(width+2*k.DMZ, height+2*k.DMZ)
+	canvas.StartviewUnitF(doc_width, doc_height, unit, 0, 0, width+2*k.DMZ, height+2*k.DMZ)
+
+	// draw the elements
+	if k.Engraving.Enabled() {
+		canvas.Group(`id="cut"`)
+	}
+	k.DrawSvgPolygons(canvas, k.Layers[layer].KeepPolys, k.LayerArcs(layer), k.SvgStyle)
+
+	// draw the engraving on its own group
+	if k.Engraving.Enabled() {
+		canvas.Gend()
+		canvas.Group(`id="engrave"`)
+		for _, mark := range k.AlignmentMarks(layer) {
+			canvas.LineF(mark[0].X, mark[0].Y, mark[1].X, mark[1].Y, k.Engraving.Style(k.LineWeight))
+		}
+		for _, label := range k.Layers[layer].Labels {
+			label.WriteSvg(canvas.Writer, k.Engraving.Color)
+		}
+		canvas.Gend()
+	}
+
+	// mark the design rule violations
+	if k.DesignRules.Overlay {
+		for _, w := range k.Result.Warnings {
+			if w.Layer == layer && (w.Code == DRC_MIN_WEB || w.Code == DRC_MIN_FEATURE) {
+				canvas.CircleF(w.Location.X, w.Location.Y, DRC_MARKER, DRC_STYLE)
+			}
+		}
+	}
+
+	canvas.End()
+	file.Close() // close written svg
+	return nil
+}
+
+// Draw the polygons to the svg, as true arcs if they are enabled.
+func (k *KAD) DrawSvgPolygons(canvas *svg.SVG, polys []Path, arcs *ArcIndex, style string) {
+	xs, ys := make([]float64, 0), make([]float64, 0)
+	for _, poly := range polys {
+		if len(poly) > 0 && k.TrueArcs {
+			canvas.Path(poly.Contour(arcs).SvgPath(), style)
+		} else if len(poly) > 0 {
+			xs, ys = poly.SplitOnAxis()
+			canvas.PolygonF(xs, ys, style)
+		}
+	}
+}
+
+// Get the arcs of the curves drawn on a layer.
+func (k *KAD) LayerArcs(layer string) *ArcIndex {
+	if l, ok := k.Layers[layer]; ok && l.Arcs != nil {
+		return l.Arcs
+	}
+	return &k.Arcs
+}
+
+// Get the formats of the files written for a layer, the sheets only have the cut files.
+func (k *KAD) LayerFormats(layer string) []string {
+	if !in_strings(layer, k.Result.Sheets) {
+		return k.Result.Formats
+	}
+	formats := make([]string, 0)
+	for _, ext := range []string{"svg", "dxf"} {
+		if in_strings(ext, k.Result.Formats) {
+			formats = append(formats, ext)
+		}
+	}
+	return formats
+}
+
 // Store the generated SVG files in an object store.
 func (k *KAD) StoreSwiftFiles() {
 	log.Printf("started uploading %s\n", k.Hash)
@@ -488,8 +737,9 @@ func (k *KAD) StoreSwiftFiles() {
 	concurrency := 5
 	give_up_after := 3
 
-	for _, layer := range k.Result.Plates {
+	for _, layer := range append(append([]string{}, k.Result.Plates...), k.Result.Sheets...) {
 		exports := []Export{}
+		formats := k.LayerFormats(layer)
 
 		sem := make(chan bool, concurrency)
 		buffer := make(chan UploadCtl, concurrency)
@@ -501,59 +751,15 @@ func (k *KAD) StoreSwiftFiles() {
 				defer func() { <-sem }() // semaphore release
 				control.Attempt = control.Attempt + 1
 
-				file_path, err := filepath.Abs(fmt.Sprintf("%s%s_%s.%s", k.FileDirectory, k.Hash, layer, ext))
+				url, file_path, err := k.UploadSwiftFile(fmt.Sprintf("%s_%s.%s", k.Hash, layer, ext))
+				control.DelFile = file_path
 				if err != nil {
-					log.Printf("ERROR: Unable to create filepath '%s'\n%s", file_path, err.Error())
-					control.Error = err
-					control.FailedExt = ext
-					buffer <- control
-					return
-				}
-
-				// check that the file exists
-				if _, err := os.Stat(file_path); err == nil {
-					// make sure the swift directory is in place
-					obj, _, err := k.Swift.Object(k.SwiftBucket, k.Hash)
-					if err != nil || obj.ContentType != "application/directory" {
-						err = k.Swift.ObjectPutString(k.SwiftBucket, k.Hash, "", "application/directory")
-						if err != nil {
-							log.Printf("ERROR: Problem creating folder '%s' (not required)\n%s", k.Hash, err.Error())
-						}
-					}
-
-					// upload the file
-					obj_path := fmt.Sprintf("%s/%s_%s.%s", k.Hash, k.Hash, layer, ext)
-					f, err := os.Open(file_path)
-					if err != nil {
-						log.Printf("ERROR: Problem opening file '%s'\n%s", file_path, err.Error())
-						control.Error = err
-						control.FailedExt = ext
-						buffer <- control
-						return
-					}
-					defer f.Close()
-					_, err = k.Swift.ObjectPut(k.SwiftBucket, obj_path, f, false, "", "", nil)
-					if err != nil {
-						log.Printf("ERROR: Problem uploading object '%s'\n%s", obj_path, err.Error())
-						control.Error = err
-						control.FailedExt = ext
-						buffer <- control
-						return
-					}
-					control.Export = &Export{
-						Ext: ext,
-						Url: fmt.Sprintf(
-							"%s%s/%s/%s_%s.%s", k.FileServePath, k.SwiftBucket, k.Hash, k.Hash, layer, ext),
-					}
-					control.DelFile = file_path
-					// send control by default
-				} else {
-					log.Printf("ERROR: File not found '%s'", file_path)
 					control.Error = err
 					control.FailedExt = ext
 					buffer <- control
 					return
 				}
+				control.Export = &Export{Ext: ext, Url: url}
 
 				buffer <- control
 			}(ext) // call function
@@ -561,12 +767,12 @@ func (k *KAD) StoreSwiftFiles() {
 
 		// since the semaphore channel has limited size,
 		// this loop must exit in order for the reads to happen
-		for _, ext := range k.Result.Formats {
+		for _, ext := range formats {
 			go process_file(ext, UploadCtl{}) // queue up the files
 		}
 
 		// read from the channel of concurrent results
-		expect_total := len(k.Result.Formats) // number of tries we know of so far
+		expect_total := len(formats) // number of tries we know of so far
 		for i := 0; i < expect_total; i++ {
 			select {
 			case result := <-buffer:
@@ -598,6 +804,26 @@ func (k *KAD) StoreSwiftFiles() {
 		k.Result.Details[layer].Exports = exports
 
 	}
+
+	// the files made from the whole design are uploaded one at a time
+	for _, list := range []*[]Export{&k.Result.Models, &k.Result.Pcb, &k.Result.Combined, &k.Result.Previews} {
+		exports := []Export{}
+		for _, e := range *list {
+			for attempt := 1; attempt <= give_up_after; attempt++ {
+				url, file_path, err := k.UploadSwiftFile(path.Base(e.Url))
+				if err == nil {
+					exports = append(exports, Export{Ext: e.Ext, Url: url})
+				}
+				if err == nil || attempt == give_up_after {
+					if file_path != "" {
+						delete_files = append(delete_files, file_path) // clean up after upload
+					}
+					break
+				}
+			}
+		}
+		*list = exports
+	}
 	log.Printf("finished uploading %s\n", k.Hash)
 	// remove formats that failed
 	if len(failed_exts) > 0 {
@@ -618,15 +844,54 @@ func (k *KAD) StoreSwiftFiles() {
 	}
 }
 
+// Upload a generated file to the object store, returning its url and the local file to clean up.
+func (k *KAD) UploadSwiftFile(name string) (string, string, error) {
+	file_path, err := filepath.Abs(k.FileDirectory + name)
+	if err != nil {
+		log.Printf("ERROR: Unable to create filepath '%s'\n%s", file_path, err.Error())
+		return "", "", err
+	}
+
+	// check that the file exists
+	if _, err := os.Stat(file_path); err != nil {
+		log.Printf("ERROR: File not found '%s'", file_path)
+		return "", "", err
+	}
+
+	// make sure the swift directory is in place
+	obj, _, err := k.Swift.Object(k.SwiftBucket, k.Hash)
+	if err != nil || obj.ContentType != "application/directory" {
+		err = k.Swift.ObjectPutString(k.SwiftBucket, k.Hash, "", "application/directory")
+		if err != nil {
+			log.Printf("ERROR: Problem creating folder '%s' (not required)\n%s", k.Hash, err.Error())
+		}
+	}
+
+	// upload the file
+	obj_path := fmt.Sprintf("%s/%s", k.Hash, name)
+	f, err := os.Open(file_path)
+	if err != nil {
+		log.Printf("ERROR: Problem opening file '%s'\n%s", file_path, err.Error())
+		return "", file_path, err
+	}
+	defer f.Close()
+	_, err = k.Swift.ObjectPut(k.SwiftBucket, obj_path, f, false, "", "", nil)
+	if err != nil {
+		log.Printf("ERROR: Problem uploading object '%s'\n%s", obj_path, err.Error())
+		return "", file_path, err
+	}
+	return fmt.Sprintf("%s%s/%s", k.FileServePath, k.SwiftBucket, obj_path), file_path, nil
+}
+
 // Store and serve the generated SVG files locally.
 func (k *KAD) StoreLocalFiles() {
 	log.Printf("saving locally %s\n", k.Hash)
 	failed_exts := []string{}
 	_ = os.Mkdir(k.FileDirectory, 0755)
-	for _, layer := range k.Result.Plates {
+	for _, layer := range append(append([]string{}, k.Result.Plates...), k.Result.Sheets...) {
 		exports := []Export{}
 		failed := false
-		for _, ext := range k.Result.Formats {
+		for _, ext := range k.LayerFormats(layer) {
 			file_path, err := filepath.Abs(fmt.Sprintf("%s%s_%s.%s", k.FileDirectory, k.Hash, layer, ext))
 			if err != nil {
 				log.Printf("ERROR: Unable to create filepath '%s'\n%s", file_path, err.Error())
@@ -721,3 +986,12 @@ func in_floats(query float64, floats []float64) bool {
 	}
 	return false
 }
+
+func in_points(query Point, points Path, tolerance float64) bool {
+	for _, pt := range points {
+		if math.Hypot(pt.X-query.X, pt.Y-query.Y) < tolerance {
+			return true
+		}
+	}
+	return false
+}
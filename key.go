@@ -34,8 +34,12 @@ type Key struct {
 	Stab          int     `json:"_s"` // stab type as int
 	Kerf          float64 `json:"_k"` // kerf for this key
 	Custom        string  `json:"_c"` // center point as custom index
+	Label         string  `json:"-"`  // legend from the KLE label of the key
 	Stacked       bool
 	Bounds        Path
+	Center        Point   `json:"-"`   // center of the switch opening, in the final position of the layers
+	Angle         float64 `json:"-"`   // rotation of the switch opening in degrees, including the cluster rotation
+	Cutouts       []Path  `json:"-"`   // switch and stabilizer openings for this key
 	Rotate        float64 `json:"_r"`  // rotate switch opening in degrees
 	RotateStab    float64 `json:"_rs"` // rotate stabilizer opening in degrees
 	RotateCluster float64 `json:"r"`   // rotate the following cluster of keys (in degrees)
@@ -104,10 +108,8 @@ func (key *Key) Draw(k *KAD, c Point, ctx Key, init bool) {
 	if !in_ints(key.Stab, []int{STABREMOVE, STABCHERRYCOSTAR, STABCHERRY, STABCOSTAR, STABALPS}) {
 		key.Stab = k.StabType
 	}
-	if key.Kerf != 0 {
-		key.Kerf = key.Kerf / 2
-	} else {
-		key.Kerf = k.Kerf
+	if key.Kerf != 0 { // the global kerf is applied to the whole layer, so only keep the difference
+		key.Kerf = key.Kerf/2 - k.Kerf
 	}
 	// handle custom polygons centered at this key
 	if key.Custom != "" {
@@ -158,6 +160,13 @@ func (key *Key) Draw(k *KAD, c Point, ctx Key, init bool) {
 		bound_path.RotatePath(ctx.RotateCluster, Point{ctx.Xabs*k.U1 + k.DMZ + k.LeftPad, ctx.Yabs*k.U1 + k.DMZ + k.TopPad})
 	}
 	k.UpdateBounds(bound_path, init)
+	key.Bounds = bound_path
+	center := Path{c}
+	if ctx.RotateCluster != 0 {
+		center.RotatePath(ctx.RotateCluster, Point{ctx.Xabs*k.U1 + k.DMZ + k.LeftPad, ctx.Yabs*k.U1 + k.DMZ + k.TopPad})
+	}
+	key.Center = center[0]
+	key.Angle = key.Rotate + ctx.RotateCluster
 
 	// add the top layer cutouts for sandwich cases
 	if k.Case.Type == CASE_SANDWICH {
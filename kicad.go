@@ -0,0 +1,170 @@
+package kad
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	KICAD_SWITCH    = "Button_Switch_Keyboard:SW_Cherry_MX_1.00u_PCB"
+	KICAD_STAB      = "Button_Switch_Keyboard:Stabilizer_Cherry_MX_{size}u"
+	KICAD_HOLE      = "MountingHole:MountingHole_3.2mm_M3"
+	KICAD_PCB       = "pcb" // name used for the board files
+	KICAD_EDGE_LINE = 0.1   // width of the board outline in mm
+)
+
+// Footprints used when placing the keys and mount holes on a KiCad board.
+type KicadOptions struct {
+	Enabled         bool   `json:"enabled"`          // write the board and the position file for the whole design
+	SwitchFootprint string `json:"switch-footprint"` // footprint of the switches
+	StabFootprint   string `json:"stab-footprint"`   // footprint of the stabilizers, '{size}' is replaced with the key size
+	HoleFootprint   string `json:"hole-footprint"`   // footprint of the mount holes
+}
+
+// A footprint placed on the board.
+type KicadFootprint struct {
+	Ref    string
+	Value  string
+	Name   string // library and name of the footprint
+	At     Point
+	Rotate float64 // counter clockwise in degrees, as kicad measures it
+}
+
+// Get the footprints for the switches, stabilizers and mount holes, at the resolved key centers and rotations.
+func (k *KAD) KicadFootprints() []KicadFootprint {
+	o := k.Kicad
+	if o.SwitchFootprint == "" {
+		o.SwitchFootprint = KICAD_SWITCH
+	}
+	if o.StabFootprint == "" {
+		o.StabFootprint = KICAD_STAB
+	}
+	if o.HoleFootprint == "" {
+		o.HoleFootprint = KICAD_HOLE
+	}
+	switches, stabs := make([]KicadFootprint, 0), make([]KicadFootprint, 0)
+	for _, row := range k.Layout {
+		for _, key := range row {
+			value := key.Label
+			if value == "" {
+				value = fmt.Sprintf("%gu", key.Width)
+			}
+			switches = append(switches, KicadFootprint{
+				Ref:    fmt.Sprintf("SW%d", len(switches)+1),
+				Value:  value,
+				Name:   o.SwitchFootprint,
+				At:     key.Center,
+				Rotate: -key.Angle,
+			})
+			size, turn := key.Width, 0.0
+			if key.Height > key.Width {
+				size, turn = key.Height, 90
+			}
+			if size >= 2 && key.Stab != STABREMOVE {
+				stabs = append(stabs, KicadFootprint{
+					Ref:    fmt.Sprintf("ST%d", len(stabs)+1),
+					Value:  fmt.Sprintf("%gu", size),
+					Name:   strings.Replace(o.StabFootprint, "{size}", fmt.Sprintf("%.2f", size), -1),
+					At:     key.Center,
+					Rotate: -(key.Angle + key.RotateStab + turn),
+				})
+			}
+		}
+	}
+	footprints := append(switches, stabs...)
+	for i, h := range k.MountHoles {
+		footprints = append(footprints, KicadFootprint{
+			Ref:   fmt.Sprintf("H%d", i+1),
+			Value: "MountingHole",
+			Name:  o.HoleFootprint,
+			At:    h,
+		})
+	}
+	return footprints
+}
+
+// Write the KiCad board and the footprint positions.
+func (k *KAD) WriteKicad(abs_base string) {
+	if !k.Kicad.Enabled {
+		return
+	}
+	files := [][2]string{{"kicad_pcb", KICAD_PCB + ".kicad_pcb"}, {"csv", KICAD_PCB + "-pos.csv"}}
+	for _, f := range files {
+		var err error
+		if f[0] == "kicad_pcb" {
+			err = k.WriteKicadPcb(fmt.Sprintf("%s_%s", abs_base, f[1]))
+		} else {
+			err = k.WriteKicadPositions(fmt.Sprintf("%s_%s", abs_base, f[1]))
+		}
+		if err != nil {
+			log.Printf("ERROR: could not create %s file for: %s | %s", f[1], k.Hash, err.Error())
+			continue
+		}
+		k.Result.Pcb = append(k.Result.Pcb, Export{
+			Ext: f[0],
+			Url: fmt.Sprintf("%s%s_%s", k.FileServePath, k.Hash, f[1]),
+		})
+	}
+}
+
+// Write a KiCad board with the case interior as the Edge.Cuts outline and the footprints placed on it.
+// The footprints only have their references, so use 'Update Footprints from Library' in KiCad to fill them in.
+func (k *KAD) WriteKicadPcb(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	fmt.Fprintf(w, "(kicad_pcb (version 20211014) (generator kad)\n")
+	fmt.Fprintf(w, "  (general (thickness 1.6))\n  (paper \"A3\")\n")
+	fmt.Fprintf(w, "  (layers\n    (0 \"F.Cu\" signal)\n    (31 \"B.Cu\" signal)\n    (37 \"F.SilkS\" user \"F.Silkscreen\")\n")
+	fmt.Fprintf(w, "    (44 \"Edge.Cuts\" user)\n    (49 \"F.Fab\" user)\n  )\n  (setup (pad_to_mask_clearance 0))\n  (net 0 \"\")\n")
+	for _, path := range k.Interior {
+		for i := range path {
+			a, b := path[i], path[(i+1)%len(path)]
+			fmt.Fprintf(w, "  (gr_line (start %.4f %.4f) (end %.4f %.4f) (layer \"Edge.Cuts\") (width %.2f))\n",
+				a.X, a.Y, b.X, b.Y, KICAD_EDGE_LINE)
+		}
+	}
+	for _, f := range k.KicadFootprints() {
+		fmt.Fprintf(w, "  (footprint %q (layer \"F.Cu\") (at %.4f %.4f %s)\n", f.Name, f.At.X, f.At.Y, KicadAngle(f.Rotate))
+		fmt.Fprintf(w, "    (fp_text reference %q (at 0 -8) (layer \"F.SilkS\") (effects (font (size 1 1) (thickness 0.15))))\n", f.Ref)
+		fmt.Fprintf(w, "    (fp_text value %q (at 0 8) (layer \"F.Fab\") (effects (font (size 1 1) (thickness 0.15))))\n  )\n", f.Value)
+	}
+	fmt.Fprintf(w, ")\n")
+	return w.Flush()
+}
+
+// Write the footprint positions in the format of the KiCad position file, where the y axis points up.
+func (k *KAD) WriteKicadPositions(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	fmt.Fprintf(w, "Ref,Val,Package,PosX,PosY,Rot,Side\n")
+	for _, f := range k.KicadFootprints() {
+		fmt.Fprintf(w, "%q,%q,%q,%.4f,%.4f,%s,top\n", f.Ref, f.Value, f.Name, f.At.X, -f.At.Y, KicadAngle(f.Rotate))
+	}
+	return w.Flush()
+}
+
+// Get an angle in the range kicad uses, (-180, 180].
+func KicadAngle(a float64) string {
+	a = math.Mod(a, 360)
+	if a > 180 {
+		a -= 360
+	} else if a <= -180 {
+		a += 360
+	}
+	if a == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%g", math.Round(a*1000)/1000)
+}
@@ -0,0 +1,60 @@
+package kad
+
+import (
+	"math"
+)
+
+// Material the plates are cut from, used to estimate the weight and cost of the plates.
+type Material struct {
+	Name        string  `json:"name"`
+	Thickness   float64 `json:"thickness"`      // thickness in mm
+	Density     float64 `json:"density"`        // density in g/cm^3
+	PriceArea   float64 `json:"price-per-area"` // price per m^2 of sheet
+	PriceLength float64 `json:"price-per-cut"`  // price per m of cut
+}
+
+// Update the fabrication metrics for each layer and the weight and cost estimates for the material.
+func (k *KAD) UpdateMetrics() {
+	k.Result.Weight, k.Result.Cost = 0, 0
+	for _, layer := range k.Result.Plates {
+		d := k.Result.Details[layer]
+		pts := make(Path, 0)
+		d.CutLength, d.Pierces = 0, 0
+		for _, poly := range k.Layers[layer].KeepPolys {
+			if len(poly) == 0 {
+				continue
+			}
+			if k.TrueArcs {
+				d.CutLength += poly.Contour(k.LayerArcs(layer)).Length()
+			} else {
+				d.CutLength += poly.LineContour().Length()
+			}
+			d.Pierces++ // every closed contour needs its own pierce
+			pts = append(pts, poly...)
+		}
+		b := pts.Bounds()
+		d.SheetWidth, d.SheetHeight = b.Xmax-b.Xmin, b.Ymax-b.Ymin
+
+		// the material is charged by the sheet and the cut by the length
+		m := k.Material
+		d.Weight = d.Area * m.Thickness * m.Density / 1000 // mm^3 to cm^3
+		d.Cost = d.SheetWidth*d.SheetHeight/1e6*m.PriceArea + d.CutLength/1000*m.PriceLength
+		k.Result.Weight += d.Weight
+		k.Result.Cost += d.Cost
+	}
+}
+
+// Get the length of the contour.
+func (c Contour) Length() float64 {
+	length := 0.0
+	start := c.Start
+	for _, s := range c.Segments {
+		if s.Radius > 0 {
+			length += s.Radius * SweepAngle(start, s.End, s.Center, s.Sweep)
+		} else {
+			length += math.Hypot(s.End.X-start.X, s.End.Y-start.Y)
+		}
+		start = s.End
+	}
+	return length
+}
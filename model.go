@@ -0,0 +1,491 @@
+package kad
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	MODEL_THICKNESS = 3.0    // default thickness of a layer in mm when there is no material thickness
+	MODEL_CASE      = "case" // name used for the combined model of the stacked layers
+	MESH_EPSILON    = 1e-9
+)
+
+// The order of the layers in the case, from the bottom up.
+var MODEL_STACK = []string{BOTTOMLAYER, CLOSEDLAYER, OPENLAYER, SWITCHLAYER, TOPLAYER}
+
+// Settings for the 3D models of the layers and the stacked case.
+type ModelOptions struct {
+	Thickness map[string]float64 `json:"thickness"` // thickness of each layer in mm, defaults to the material thickness
+}
+
+type Point3 struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+type Triangle [3]Point3
+
+// Get the thickness of a layer in mm.
+func (k *KAD) LayerThickness(layer string) float64 {
+	if t, ok := k.Model.Thickness[layer]; ok && t > 0 {
+		return t
+	}
+	if k.Material.Thickness > 0 {
+		return k.Material.Thickness
+	}
+	return MODEL_THICKNESS
+}
+
+// Get the polygons of a layer with the y axis pointing up.
+func (k *KAD) LayerPathsUp(layer string) []Path {
+	height := k.Layers[layer].Height + 2*k.DMZ
+	paths := make([]Path, 0)
+	for _, poly := range k.Layers[layer].KeepPolys {
+		flipped := make(Path, len(poly))
+		for i, p := range poly {
+			flipped[i] = Point{p.X, height - p.Y}
+		}
+		paths = append(paths, flipped)
+	}
+	return paths
+}
+
+// Get the mesh of a layer extruded from 'z' up by its thickness, with the y axis pointing up.
+func (k *KAD) LayerMesh(layer string, z float64) []Triangle {
+	return ExtrudePaths(k.LayerPathsUp(layer), z, z+k.LayerThickness(layer))
+}
+
+// Check if any of the outputs of the layers stacked into the case are requested.
+func (k *KAD) StackOutputs() bool {
+	for _, ext := range []string{"stl", "3mf", "scad", "png"} {
+		if in_strings(ext, k.Result.Formats) {
+			return true
+		}
+	}
+	return false
+}
+
+// Write the 3D models of the layers stacked into the case.
+func (k *KAD) WriteModels(abs_base string) {
+	names := make([]string, 0)
+	// a layer which is split into pieces is not a plate any more, so it is left out (SegmentPlates warns about it)
+	for _, layer := range MODEL_STACK {
+		if in_strings(layer, k.Result.Plates) {
+			names = append(names, layer)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	for _, ext := range []string{"stl", "3mf", "scad"} {
+		if !in_strings(ext, k.Result.Formats) {
+			continue
+		}
+		path := fmt.Sprintf("%s_%s.%s", abs_base, MODEL_CASE, ext)
+		var err error
+		if ext == "scad" {
+			err = k.WriteScadCase(path, names)
+		} else {
+			objects := make(map[string][]Triangle)
+			tris := make([]Triangle, 0)
+			z := 0.0
+			for _, layer := range names {
+				objects[layer] = k.LayerMesh(layer, z)
+				tris = append(tris, objects[layer]...)
+				z += k.LayerThickness(layer)
+			}
+			if ext == "stl" {
+				err = WriteStl(path, tris)
+			} else {
+				err = Write3mf(path, names, objects)
+			}
+		}
+		if err != nil {
+			log.Printf("ERROR: could not create %s case model for: %s | %s", ext, k.Hash, err.Error())
+			continue
+		}
+		k.Result.Models = append(k.Result.Models, Export{
+			Ext: ext,
+			Url: fmt.Sprintf("%s%s_%s.%s", k.FileServePath, k.Hash, MODEL_CASE, ext),
+		})
+	}
+}
+
+// Write a layer as a 3D model, sitting on z=0.
+func (k *KAD) WriteLayerModel(layer, path, ext string) error {
+	tris := k.LayerMesh(layer, 0)
+	if ext == "3mf" {
+		return Write3mf(path, []string{layer}, map[string][]Triangle{layer: tris})
+	}
+	return WriteStl(path, tris)
+}
+
+// Extrude the paths (with holes) from 'z0' to 'z1' into a closed mesh with the normals pointing out.
+func ExtrudePaths(paths []Path, z0, z1 float64) []Triangle {
+	tris := make([]Triangle, 0)
+	for _, t := range TriangulatePaths(paths) {
+		tris = append(tris,
+			Triangle{{t[0].X, t[0].Y, z1}, {t[1].X, t[1].Y, z1}, {t[2].X, t[2].Y, z1}},
+			Triangle{{t[0].X, t[0].Y, z0}, {t[2].X, t[2].Y, z0}, {t[1].X, t[1].Y, z0}})
+	}
+	for _, path := range OrientPaths(paths) {
+		for i := range path {
+			a, b := path[i], path[(i+1)%len(path)]
+			if a == b {
+				continue
+			}
+			tris = append(tris,
+				Triangle{{a.X, a.Y, z0}, {b.X, b.Y, z0}, {b.X, b.Y, z1}},
+				Triangle{{a.X, a.Y, z0}, {b.X, b.Y, z1}, {a.X, a.Y, z1}})
+		}
+	}
+	return tris
+}
+
+// Orient the paths so the outer paths are counter clockwise and the holes are clockwise.
+// A path is a hole if it is inside an odd number of the other paths.
+func OrientPaths(paths []Path) []Path {
+	oriented := make([]Path, 0)
+	for i, path := range paths {
+		if path = path.Simplify(); len(path) < 3 {
+			continue
+		}
+		depth := 0
+		for j, other := range paths {
+			if i != j && other.Contains(path[0]) {
+				depth++
+			}
+		}
+		if (depth%2 == 0) != (path.SignedArea() > 0) {
+			reversed := make(Path, len(path))
+			for n := range path {
+				reversed[n] = path[len(path)-1-n]
+			}
+			path = reversed
+		}
+		oriented = append(oriented, path)
+	}
+	return oriented
+}
+
+// Get the path without the repeated points and the points in the middle of straight edges.
+func (ps Path) Simplify() Path {
+	simple := append(Path{}, ps...)
+	for i := 0; len(simple) > 2 && i < len(simple); {
+		n := len(simple)
+		a, b, c := simple[(i+n-1)%n], simple[i], simple[(i+1)%n]
+		l := math.Hypot(b.X-a.X, b.Y-a.Y) * math.Hypot(c.X-b.X, c.Y-b.Y)
+		dot := (b.X-a.X)*(c.X-b.X) + (b.Y-a.Y)*(c.Y-b.Y)
+		if l == 0 || (math.Abs(Cross(a, b, c)) <= MESH_EPSILON*l && dot > 0) {
+			simple = append(simple[:i], simple[i+1:]...)
+			continue
+		}
+		i++
+	}
+	return simple
+}
+
+// Group the paths into polygons, each with its counter clockwise outer path first and then its clockwise holes.
+// A hole belongs to the smallest outer path around it.
+func NestPaths(paths []Path) [][]Path {
+	paths = OrientPaths(paths)
+	polygons := make([][]Path, 0)
+	for _, path := range paths {
+		if path.SignedArea() > 0 {
+			polygons = append(polygons, []Path{path})
+		}
+	}
+	for _, path := range paths {
+		if path.SignedArea() > 0 {
+			continue
+		}
+		parent, area := -1, 0.0
+		for i, polygon := range polygons {
+			if a := polygon[0].SignedArea(); polygon[0].Contains(path[0]) && (parent < 0 || a < area) {
+				parent, area = i, a
+			}
+		}
+		if parent >= 0 {
+			polygons[parent] = append(polygons[parent], path)
+		}
+	}
+	return polygons
+}
+
+// Triangulate the paths (with holes), the triangles are counter clockwise.
+func TriangulatePaths(paths []Path) [][3]Point {
+	tris := make([][3]Point, 0)
+	for _, polygon := range NestPaths(paths) {
+		tris = append(tris, EarClip(BridgeHoles(polygon[0], polygon[1:]))...)
+	}
+	return tris
+}
+
+// Join the holes into the counter clockwise outer path, through a bridge from the right most point of each hole.
+func BridgeHoles(outer Path, holes []Path) Path {
+	poly := append(Path{}, outer...)
+	sort.SliceStable(holes, func(i, j int) bool {
+		return holes[i].Bounds().Xmax > holes[j].Bounds().Xmax
+	})
+	for _, hole := range holes {
+		m := 0
+		for i, p := range hole {
+			if p.X > hole[m].X {
+				m = i
+			}
+		}
+		mp := hole[m]
+
+		// find the closest edge to the right of the hole
+		best, bx := -1, math.Inf(1)
+		for i := range poly {
+			a, b := poly[i], poly[(i+1)%len(poly)]
+			if (a.Y > mp.Y) == (b.Y > mp.Y) {
+				continue
+			}
+			x := a.X + (mp.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if x >= mp.X && x < bx {
+				best, bx = i, x
+			}
+		}
+		if best < 0 {
+			continue
+		}
+		pi := best
+		if poly[(best+1)%len(poly)].X > poly[best].X {
+			pi = (best + 1) % len(poly)
+		}
+
+		// a reflex point inside the triangle to the edge would block the bridge, so use the one closest to the ray
+		ip := Point{bx, mp.Y}
+		angle := math.Inf(1)
+		for i, p := range poly {
+			if i == pi || p == poly[pi] || !IsReflex(poly, i) || !InTriangle(p, mp, ip, poly[pi]) {
+				continue
+			}
+			if a := math.Abs(math.Atan2(p.Y-mp.Y, p.X-mp.X)); a < angle {
+				pi, angle = i, a
+			}
+		}
+
+		merged := append(Path{}, poly[:pi+1]...)
+		for i := 0; i <= len(hole); i++ {
+			merged = append(merged, hole[(m+i)%len(hole)])
+		}
+		merged = append(merged, poly[pi])
+		poly = append(merged, poly[pi+1:]...)
+	}
+	return poly
+}
+
+// Check if the point at index 'i' of the counter clockwise path turns clockwise.
+func IsReflex(ps Path, i int) bool {
+	n := len(ps)
+	return Cross(ps[(i+n-1)%n], ps[i], ps[(i+1)%n]) < 0
+}
+
+// Get the z component of the cross product of the edges a->b and b->c.
+func Cross(a, b, c Point) float64 {
+	return (b.X-a.X)*(c.Y-b.Y) - (b.Y-a.Y)*(c.X-b.X)
+}
+
+// Check if the point is inside or on the edge of the triangle, in either orientation.
+func InTriangle(p, a, b, c Point) bool {
+	d1, d2, d3 := Cross(a, b, p), Cross(b, c, p), Cross(c, a, p)
+	neg := d1 < -MESH_EPSILON || d2 < -MESH_EPSILON || d3 < -MESH_EPSILON
+	pos := d1 > MESH_EPSILON || d2 > MESH_EPSILON || d3 > MESH_EPSILON
+	return !(neg && pos)
+}
+
+// Triangulate a simple counter clockwise path by clipping off its ears.
+// Points with no area (such as along the bridges to the holes) are only clipped when there are no ears left,
+// which keeps every edge of the path in the triangles.
+func EarClip(poly Path) [][3]Point {
+	tris := make([][3]Point, 0)
+	idx := make([]int, len(poly))
+	for i := range idx {
+		idx[i] = i
+	}
+	corner := func(i int) (Point, Point, Point) {
+		n := len(idx)
+		return poly[idx[(i+n-1)%n]], poly[idx[i%n]], poly[idx[(i+1)%n]]
+	}
+	// bucket the points into a grid, so an ear is only checked against the points near it
+	b := poly.Bounds()
+	cell := math.Max(math.Sqrt((b.Xmax-b.Xmin)*(b.Ymax-b.Ymin)/float64(len(poly)+1)), MESH_EPSILON)
+	grid := make(map[[2]int][]int)
+	key := func(p Point) [2]int {
+		return [2]int{int(math.Floor((p.X - b.Xmin) / cell)), int(math.Floor((p.Y - b.Ymin) / cell))}
+	}
+	for i, p := range poly {
+		grid[key(p)] = append(grid[key(p)], i)
+	}
+	clipped := make([]bool, len(poly))
+	clip := func(i int) {
+		a, b, c := corner(i)
+		tris = append(tris, [3]Point{a, b, c})
+		clipped[idx[i]] = true
+		idx = append(idx[:i], idx[i+1:]...)
+	}
+	blocked := func(a, b, c Point) bool {
+		lo := key(Point{math.Min(a.X, math.Min(b.X, c.X)), math.Min(a.Y, math.Min(b.Y, c.Y))})
+		hi := key(Point{math.Max(a.X, math.Max(b.X, c.X)), math.Max(a.Y, math.Max(b.Y, c.Y))})
+		for x := lo[0]; x <= hi[0]; x++ {
+			for y := lo[1]; y <= hi[1]; y++ {
+				for _, j := range grid[[2]int{x, y}] {
+					p := poly[j]
+					if !clipped[j] && p != a && p != b && p != c && InTriangle(p, a, b, c) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+	i, misses := 0, 0
+	for len(idx) > 3 {
+		n := len(idx)
+		i = i % n
+		if misses > n { // no ears left, so clip a point with no area, or any point rather than never finishing
+			flat := i
+			for j := 0; j < n; j++ {
+				if a, b, c := corner(j); math.Abs(Cross(a, b, c)) <= MESH_EPSILON {
+					flat = j
+					break
+				}
+			}
+			clip(flat)
+			misses = 0
+			continue
+		}
+		a, b, c := corner(i)
+		if Cross(a, b, c) > MESH_EPSILON && !blocked(a, b, c) {
+			clip(i)
+			misses = 0
+			continue
+		}
+		i++
+		misses++
+	}
+	if len(idx) == 3 {
+		clip(1)
+	}
+	return tris
+}
+
+// Get the unit normal of the triangle.
+func (t Triangle) Normal() Point3 {
+	u := Point3{t[1].X - t[0].X, t[1].Y - t[0].Y, t[1].Z - t[0].Z}
+	v := Point3{t[2].X - t[0].X, t[2].Y - t[0].Y, t[2].Z - t[0].Z}
+	n := Point3{u.Y*v.Z - u.Z*v.Y, u.Z*v.X - u.X*v.Z, u.X*v.Y - u.Y*v.X}
+	l := math.Sqrt(n.X*n.X + n.Y*n.Y + n.Z*n.Z)
+	if l == 0 {
+		return n
+	}
+	return Point3{n.X / l, n.Y / l, n.Z / l}
+}
+
+// Write the triangles to a binary STL file.
+func WriteStl(path string, tris []Triangle) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	header := make([]byte, 80)
+	copy(header, "kad")
+	w.Write(header)
+	binary.Write(w, binary.LittleEndian, uint32(len(tris)))
+	for _, t := range tris {
+		n := t.Normal()
+		data := []float32{float32(n.X), float32(n.Y), float32(n.Z)}
+		for _, p := range t {
+			data = append(data, float32(p.X), float32(p.Y), float32(p.Z))
+		}
+		binary.Write(w, binary.LittleEndian, data)
+		binary.Write(w, binary.LittleEndian, uint16(0))
+	}
+	return w.Flush()
+}
+
+// Write the named objects to a 3MF file, in millimeters.
+func Write3mf(path string, names []string, objects map[string][]Triangle) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	z := zip.NewWriter(file)
+	parts := [][2]string{
+		{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="model" ContentType="application/vnd.ms-package.3dmanufacturing-3dmodel+xml"/>
+</Types>
+`},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Target="/3D/3dmodel.model" Id="rel0" Type="http://schemas.microsoft.com/3dmanufacturing/2013/01/3dmodel"/>
+</Relationships>
+`},
+	}
+	for _, part := range parts {
+		w, err := z.Create(part[0])
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write([]byte(part[1])); err != nil {
+			return err
+		}
+	}
+
+	w, err := z.Create("3D/3dmodel.model")
+	if err != nil {
+		return err
+	}
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<model unit=\"millimeter\" xml:lang=\"en-US\" xmlns=\"http://schemas.microsoft.com/3dmanufacturing/core/2015/02\">\n<resources>\n")
+	for i, name := range names {
+		// share the vertices between the triangles
+		index := make(map[Point3]int)
+		vertices := make([]Point3, 0)
+		var tris strings.Builder
+		for _, t := range objects[name] {
+			v := [3]int{}
+			for j, p := range t {
+				if _, ok := index[p]; !ok {
+					index[p] = len(vertices)
+					vertices = append(vertices, p)
+				}
+				v[j] = index[p]
+			}
+			if v[0] != v[1] && v[1] != v[2] && v[0] != v[2] {
+				fmt.Fprintf(&tris, "<triangle v1=\"%d\" v2=\"%d\" v3=\"%d\"/>\n", v[0], v[1], v[2])
+			}
+		}
+		fmt.Fprintf(b, "<object id=\"%d\" name=\"%s\" type=\"model\">\n<mesh>\n<vertices>\n", i+1, name)
+		for _, p := range vertices {
+			fmt.Fprintf(b, "<vertex x=\"%.4f\" y=\"%.4f\" z=\"%.4f\"/>\n", p.X, p.Y, p.Z)
+		}
+		fmt.Fprintf(b, "</vertices>\n<triangles>\n%s</triangles>\n</mesh>\n</object>\n", tris.String())
+	}
+	fmt.Fprintf(b, "</resources>\n<build>\n")
+	for i := range names {
+		fmt.Fprintf(b, "<item objectid=\"%d\"/>\n", i+1)
+	}
+	fmt.Fprintf(b, "</build>\n</model>\n")
+	if err = b.Flush(); err != nil {
+		return err
+	}
+	return z.Close()
+}
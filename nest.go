@@ -0,0 +1,194 @@
+package kad
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	SHEETLAYER      = "sheet"
+	SHEETLAYER_NAME = "Sheet"
+	NEST_TOO_LARGE  = "nesting-too-large"
+)
+
+// Stock sheets the plates are packed onto.
+type Nesting struct {
+	SheetWidth  float64        `json:"sheet-width"`  // width of the stock sheet in mm
+	SheetHeight float64        `json:"sheet-height"` // height of the stock sheet in mm
+	Spacing     float64        `json:"spacing"`      // space between the plates and around the edge of the sheet in mm
+	Rotate      bool           `json:"rotate"`       // allow the plates to be rotated by 90 degrees
+	Copies      map[string]int `json:"copies"`       // number of copies of each layer, defaults to 1
+}
+
+// A plate which has been placed on a sheet.
+type Placement struct {
+	Layer   string  `json:"layer"`
+	X       float64 `json:"x"`
+	Y       float64 `json:"y"`
+	Rotated bool    `json:"rotated"`
+}
+
+type shelf struct {
+	Y      float64
+	Height float64
+	X      float64 // next free position on the shelf
+}
+
+type sheet struct {
+	Shelves []shelf
+	Parts   []Placement
+}
+
+// Pack every plate onto stock sheets, each sheet is added to the sheets of the result.
+// The plates are packed by their bounds into shelves, tallest plates first.
+func (k *KAD) NestPlates() {
+	n := k.Nesting
+	if n.SheetWidth <= 0 || n.SheetHeight <= 0 {
+		return
+	}
+	type part struct {
+		Layer  string
+		Bounds Bounds
+		W, H   float64
+	}
+	parts := make([]part, 0)
+	for _, layer := range k.Result.Plates {
+		copies, ok := n.Copies[layer]
+		if !ok {
+			copies = 1
+		}
+		pts := make(Path, 0)
+		for _, poly := range k.Layers[layer].KeepPolys {
+			pts = append(pts, poly...)
+		}
+		if len(pts) == 0 {
+			continue
+		}
+		b := pts.Bounds()
+		for i := 0; i < copies; i++ {
+			parts = append(parts, part{layer, b, b.Xmax - b.Xmin, b.Ymax - b.Ymin})
+		}
+	}
+	sort.SliceStable(parts, func(i, j int) bool {
+		return math.Min(parts[i].W, parts[i].H) > math.Min(parts[j].W, parts[j].H)
+	})
+
+	// place the part on the sheet if it fits, either on an existing shelf or on a new one
+	place := func(s *sheet, layer string, w, h float64, rotated bool) bool {
+		for i := range s.Shelves {
+			sh := &s.Shelves[i]
+			if h <= sh.Height && sh.X+w+n.Spacing <= n.SheetWidth {
+				s.Parts = append(s.Parts, Placement{layer, sh.X, sh.Y, rotated})
+				sh.X += w + n.Spacing
+				return true
+			}
+		}
+		y := n.Spacing
+		if len(s.Shelves) > 0 {
+			last := s.Shelves[len(s.Shelves)-1]
+			y = last.Y + last.Height + n.Spacing
+		}
+		if y+h+n.Spacing <= n.SheetHeight && n.Spacing+w+n.Spacing <= n.SheetWidth {
+			s.Shelves = append(s.Shelves, shelf{y, h, n.Spacing + w + n.Spacing})
+			s.Parts = append(s.Parts, Placement{layer, n.Spacing, y, rotated})
+			return true
+		}
+		return false
+	}
+
+	sheets := make([]*sheet, 0)
+	for _, p := range parts {
+		// lay the part flat to keep the shelves short
+		orientations := []bool{false}
+		if n.Rotate && p.H > p.W {
+			orientations = []bool{true, false}
+		} else if n.Rotate {
+			orientations = []bool{false, true}
+		}
+		fits := func(s *sheet) bool {
+			for _, rotated := range orientations {
+				w, h := p.W, p.H
+				if rotated {
+					w, h = h, w
+				}
+				if place(s, p.Layer, w, h, rotated) {
+					return true
+				}
+			}
+			return false
+		}
+		placed := false
+		for _, s := range sheets {
+			if placed = fits(s); placed {
+				break
+			}
+		}
+		if !placed { // start a new sheet
+			s := &sheet{}
+			if placed = fits(s); placed {
+				sheets = append(sheets, s)
+			}
+		}
+		if !placed {
+			k.Result.Warnings = append(k.Result.Warnings, Warning{
+				Code:    NEST_TOO_LARGE,
+				Layer:   p.Layer,
+				Message: fmt.Sprintf("The plate does not fit on a %.0f x %.0f sheet.", n.SheetWidth, n.SheetHeight),
+			})
+		}
+	}
+
+	// draw the parts onto each sheet
+	for i, s := range sheets {
+		layer := fmt.Sprintf("%s_%d", SHEETLAYER, i+1)
+		d := &ResultDetails{
+			Name:        fmt.Sprintf("%s %d", SHEETLAYER_NAME, i+1),
+			Width:       n.SheetWidth,
+			Height:      n.SheetHeight,
+			SheetWidth:  n.SheetWidth,
+			SheetHeight: n.SheetHeight,
+			Parts:       s.Parts,
+		}
+		l := &Layer{Width: n.SheetWidth, Height: n.SheetHeight, Arcs: &ArcIndex{Kerf: k.Kerf}}
+		for _, p := range s.Parts {
+			var b Bounds
+			for _, pt := range parts {
+				if pt.Layer == p.Layer {
+					b = pt.Bounds
+					break
+				}
+			}
+			place := func(pt Point) Point {
+				x, y := pt.X-b.Xmin, pt.Y-b.Ymin
+				if p.Rotated { // rotate a quarter turn clockwise
+					x, y = b.Ymax-b.Ymin-y, x
+				}
+				return Point{k.DMZ + p.X + x, k.DMZ + p.Y + y}
+			}
+			for _, poly := range k.Layers[p.Layer].KeepPolys {
+				placed := make(Path, len(poly))
+				for j, pt := range poly {
+					placed[j] = place(pt)
+				}
+				l.KeepPolys = append(l.KeepPolys, placed)
+			}
+			l.Arcs.Transform(k.LayerArcs(p.Layer), place)
+			for _, label := range k.Layers[p.Layer].Labels {
+				label.At = place(label.At)
+				if p.Rotated {
+					label.Rotate += 90
+				}
+				l.Labels = append(l.Labels, label)
+			}
+			pd := k.Result.Details[p.Layer]
+			d.Area += pd.Area
+			d.CutLength += pd.CutLength
+			d.Pierces += pd.Pierces
+		}
+		d.Utilisation = d.Area / (n.SheetWidth * n.SheetHeight) * 100
+		k.Layers[layer] = l
+		k.Result.Details[layer] = d
+		k.Result.Sheets = append(k.Result.Sheets, layer)
+	}
+}
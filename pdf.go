@@ -0,0 +1,194 @@
+package kad
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	PDF_A4      = "a4"
+	PDF_LETTER  = "letter"
+	PDF_MARGIN  = 10.0        // default margin around the printed area of a page in mm
+	PDF_PT      = 72.0 / 25.4 // points per mm
+	PDF_RULER   = 50.0        // length of the scale ruler in mm
+	PDF_MARK    = 6.0         // size of the registration marks in mm
+	PDF_OVERLAP = 8.0         // default overlap between tiled pages in mm
+)
+
+// How the plates are printed to PDF.
+type PdfOptions struct {
+	PageSize string  `json:"page-size"` // 'a4' (default) or 'letter'
+	Margin   float64 `json:"margin"`    // margin around the printed area of each page in mm
+	Overlap  float64 `json:"overlap"`   // length of the plate printed on both of two tiled pages in mm
+}
+
+// Get the width and height of the page in mm, in portrait.
+func (p PdfOptions) PageDimensions() (float64, float64) {
+	if strings.ToLower(p.PageSize) == PDF_LETTER {
+		return 215.9, 279.4
+	}
+	return 210, 297
+}
+
+// Get the number of pages across and down needed to print a layer at true scale.
+// The page is turned to landscape when that needs fewer pages.
+func (k *KAD) PdfTiles(layer string) (cols, rows int, landscape bool) {
+	m, o := k.PdfMargin(), k.PdfOverlap()
+	pw, ph := k.Pdf.PageDimensions()
+	w, h := k.Layers[layer].Width+2*k.DMZ, k.Layers[layer].Height+2*k.DMZ
+	cols, rows = PdfPageCount(w, pw-2*m, o), PdfPageCount(h, ph-2*m, o)
+	l_cols, l_rows := PdfPageCount(w, ph-2*m, o), PdfPageCount(h, pw-2*m, o)
+	if l_cols*l_rows < cols*rows {
+		return l_cols, l_rows, true
+	}
+	return cols, rows, false
+}
+
+// Get the number of pages needed to print a length when each page after the first repeats the overlap.
+func PdfPageCount(length, printable, overlap float64) int {
+	if length <= printable {
+		return 1
+	}
+	return int(math.Ceil((length - overlap) / (printable - overlap)))
+}
+
+// Get the overlap between tiled pdf pages in mm, it is kept to less than half of the printed area.
+func (k *KAD) PdfOverlap() float64 {
+	o := PDF_OVERLAP
+	if k.Pdf.Overlap > 0 {
+		o = k.Pdf.Overlap
+	}
+	pw, ph := k.Pdf.PageDimensions()
+	return math.Min(o, (math.Min(pw, ph)-2*k.PdfMargin())/2)
+}
+
+// Get the margin of the pdf pages in mm.
+func (k *KAD) PdfMargin() float64 {
+	if k.Pdf.Margin > 0 {
+		return k.Pdf.Margin
+	}
+	return PDF_MARGIN
+}
+
+// Write a layer to a PDF at true scale, tiled over as many pages as needed.
+// Tiled pages overlap by the same strip of the plate and have registration marks inside the overlap
+// which line up with the next page, and every page has a ruler to check the print scale.
+func (k *KAD) WritePdf(layer string, path string) error {
+	m := k.PdfMargin()
+	pw, ph := k.Pdf.PageDimensions()
+	cols, rows, landscape := k.PdfTiles(layer)
+	if landscape {
+		pw, ph = ph, pw
+	}
+	tw, th := pw-2*m, ph-2*m
+	o := k.PdfOverlap()
+	mark := math.Min(PDF_MARK, o)
+	name := layer
+	if d, ok := k.Result.Details[layer]; ok {
+		name = d.Name
+	}
+
+	pages := make([]string, 0)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			var s bytes.Buffer
+			// the plate, clipped to the printed area of the page
+			fmt.Fprintf(&s, "q\n%.3f %.3f %.3f %.3f re W n\n", m*PDF_PT, m*PDF_PT, tw*PDF_PT, th*PDF_PT)
+			fmt.Fprintf(&s, "%.5f 0 0 %.5f %.3f %.3f cm\n", PDF_PT, -PDF_PT, (m-float64(c)*(tw-o))*PDF_PT, (ph-m+float64(r)*(th-o))*PDF_PT)
+			fmt.Fprintf(&s, "0 0 0 RG %.3f w\n", k.LineWeight)
+			for _, poly := range k.Layers[layer].KeepPolys {
+				for i, pt := range poly {
+					op := "l"
+					if i == 0 {
+						op = "m"
+					}
+					fmt.Fprintf(&s, "%.3f %.3f %s\n", pt.X, pt.Y, op)
+				}
+				if len(poly) > 0 {
+					s.WriteString("h\n")
+				}
+			}
+			s.WriteString("S\nQ\n")
+
+			// the page furniture is drawn in mm from the bottom left of the page
+			fmt.Fprintf(&s, "q\n%.5f 0 0 %.5f 0 0 cm\n0 0 0 RG %.3f w\n", PDF_PT, PDF_PT, 0.2)
+			// the marks are centered in the overlap with each neighbouring page, so they print on both pages
+			marks := make(Path, 0)
+			add := func(pts ...Point) {
+				for _, p := range pts {
+					if !in_points(p, marks, 0.001) {
+						marks = append(marks, p)
+					}
+				}
+			}
+			left, right, top, bottom := m+o/2, m+tw-o/2, m+th-o/2, m+o/2
+			if c > 0 {
+				add(Point{left, bottom}, Point{left, top})
+			}
+			if c < cols-1 {
+				add(Point{right, bottom}, Point{right, top})
+			}
+			if r > 0 {
+				add(Point{left, top}, Point{right, top})
+			}
+			if r < rows-1 {
+				add(Point{left, bottom}, Point{right, bottom})
+			}
+			for _, p := range marks {
+				fmt.Fprintf(&s, "%.3f %.3f m %.3f %.3f l %.3f %.3f m %.3f %.3f l S\n",
+					p.X-mark/2, p.Y, p.X+mark/2, p.Y, p.X, p.Y-mark/2, p.X, p.Y+mark/2)
+			}
+			ruler := math.Min(PDF_RULER, math.Floor(tw/10)*10)
+			y := m / 2
+			fmt.Fprintf(&s, "%.3f %.3f m %.3f %.3f l S\n", m, y, m+ruler, y)
+			for x := 0.0; x <= ruler; x += 10 {
+				fmt.Fprintf(&s, "%.3f %.3f m %.3f %.3f l S\n", m+x, y, m+x, y+2)
+			}
+			s.WriteString("Q\n")
+			label := fmt.Sprintf("%s %s - page %d of %d (row %d, column %d)", k.Hash, name, r*cols+c+1, cols*rows, r+1, c+1)
+			s.WriteString(PdfText(m*PDF_PT, (ph-m/2-1)*PDF_PT, label))
+			s.WriteString(PdfText((m+ruler+2)*PDF_PT, (y-1)*PDF_PT, fmt.Sprintf("%.0f mm", ruler)))
+			pages = append(pages, s.String())
+		}
+	}
+
+	// the objects are the catalog, the page tree, the font and then a page and its contents for each page
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+	kids := make([]string, 0)
+	for i, content := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.3f %.3f] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+				pw*PDF_PT, ph*PDF_PT, 5+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, o := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return os.WriteFile(path, out.Bytes(), 0644)
+}
+
+// Get the content stream to write a line of text in points from the bottom left of the page.
+func PdfText(x, y float64, text string) string {
+	text = strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(text)
+	return fmt.Sprintf("BT /F1 8 Tf %.3f %.3f Td (%s) Tj ET\n", x, y, text)
+}
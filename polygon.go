@@ -3,6 +3,7 @@ package kad
 import (
 	"log"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/Knetic/govaluate"
@@ -10,7 +11,8 @@ import (
 )
 
 const (
-	CLIP_DIST = 0.7 // In order to just clean duplicate points: .7*.7 = 0.49 < 0.5
+	CLIP_DIST     = 0.7   // In order to just clean duplicate points: .7*.7 = 0.49 < 0.5
+	ARC_TOLERANCE = 0.005 // max distance in mm between a true arc and the segments approximating it
 )
 
 type Point struct { // point, saving space as it is used a LOT
@@ -22,6 +24,7 @@ type Path []Point
 type CustomPolygon struct {
 	Diameter float64  `json:"diameter"`
 	Height   float64  `json:"height"`
+	Import   Import   `json:"import"`
 	Layers   []string `json:"layers"`
 	Op       string   `json:"op"`
 	Points   string   `json:"points"`
@@ -59,6 +62,40 @@ func (ps Path) RotatePath(r float64, a Point) {
 	}
 }
 
+// Get the bounding box of the path.
+func (ps Path) Bounds() Bounds {
+	b := Bounds{}
+	for i := range ps {
+		if ps[i].X < b.Xmin || i == 0 {
+			b.Xmin = ps[i].X
+		}
+		if ps[i].X > b.Xmax || i == 0 {
+			b.Xmax = ps[i].X
+		}
+		if ps[i].Y < b.Ymin || i == 0 {
+			b.Ymin = ps[i].Y
+		}
+		if ps[i].Y > b.Ymax || i == 0 {
+			b.Ymax = ps[i].Y
+		}
+	}
+	return b
+}
+
+// Check if a point is inside the path (even-odd rule).
+func (ps Path) Contains(p Point) bool {
+	inside := false
+	j := len(ps) - 1
+	for i := range ps {
+		if (ps[i].Y > p.Y) != (ps[j].Y > p.Y) &&
+			p.X < (ps[j].X-ps[i].X)*(p.Y-ps[i].Y)/(ps[j].Y-ps[i].Y)+ps[i].X {
+			inside = !inside
+		}
+		j = i
+	}
+	return inside
+}
+
 // SplitOnAxis path to be drawn by SVGo
 func (ps Path) SplitOnAxis() ([]float64, []float64) {
 	xs := make([]float64, 0)
@@ -90,74 +127,202 @@ func FromClipperPath(cp clipper.Path) Path {
 	return p
 }
 
+// Union a set of paths into non overlapping paths.
+func UnionPaths(paths []Path) ([]Path, bool) {
+	c := clipper.NewClipper(clipper.IoNone)
+	for _, path := range paths {
+		c.AddPath(path.ToClipperPath(), clipper.PtSubject, true)
+	}
+	solution, ok := c.Execute1(clipper.CtUnion, clipper.PftNonZero, clipper.PftNonZero)
+	if !ok {
+		return paths, false
+	}
+	union := make([]Path, 0)
+	for _, cpath := range solution {
+		union = append(union, FromClipperPath(cpath))
+	}
+	return union, true
+}
+
+// Get the signed area of the path, the sign gives its orientation.
+func (ps Path) SignedArea() float64 {
+	area := 0.0
+	for i := range ps {
+		j := (i + 1) % len(ps)
+		area += ps[i].X*ps[j].Y - ps[j].X*ps[i].Y
+	}
+	return area / 2
+}
+
+// Get the orientation of the outer paths, the outline has the largest area so it sets the orientation.
+// Holes have the opposite orientation to the outer paths.
+func OuterSign(paths []Path) float64 {
+	sign, largest := 0.0, 0.0
+	for _, path := range paths {
+		if a := path.SignedArea(); math.Abs(a) > largest {
+			sign, largest = math.Copysign(1, a), math.Abs(a)
+		}
+	}
+	return sign
+}
+
+// Remove the area covered by the 'cut' paths from the 'keep' paths.
+func DifferencePaths(keep, cut []Path) ([]Path, bool) {
+	c := clipper.NewClipper(clipper.IoNone)
+	for _, path := range keep {
+		c.AddPath(path.ToClipperPath(), clipper.PtSubject, true)
+	}
+	for _, path := range cut {
+		c.AddPath(path.ToClipperPath(), clipper.PtClip, true)
+	}
+	solution, ok := c.Execute1(clipper.CtDifference, clipper.PftNonZero, clipper.PftNonZero)
+	if !ok {
+		return keep, false
+	}
+	diff := make([]Path, 0)
+	for _, cpath := range solution {
+		diff = append(diff, FromClipperPath(cpath))
+	}
+	return diff, true
+}
+
+// Offset a set of closed paths by 'delta' (grow if positive, shrink if negative).
+func OffsetPaths(paths []Path, delta float64, join clipper.JoinType) []Path {
+	co := clipper.NewClipperOffset()
+	co.ArcTolerance = ARC_TOLERANCE * PRECISION
+	co.MiterLimit = 4 // keep the sharp corners of the switch cutouts
+	for _, path := range paths {
+		co.AddPath(path.ToClipperPath(), join, clipper.EtClosedPolygon)
+	}
+	offset := make([]Path, 0)
+	for _, cpath := range co.Execute(delta * PRECISION) {
+		offset = append(offset, FromClipperPath(cpath))
+	}
+	return offset
+}
+
+// Check if the area covered by two sets of paths overlap.
+func PathsIntersect(a, b []Path) bool {
+	return len(IntersectPaths(a, b)) > 0
+}
+
+// Get the area covered by both sets of paths.
+func IntersectPaths(a, b []Path) []Path {
+	overlap := make([]Path, 0)
+	if len(a) == 0 || len(b) == 0 {
+		return overlap
+	}
+	c := clipper.NewClipper(clipper.IoNone)
+	for _, path := range a {
+		c.AddPath(path.ToClipperPath(), clipper.PtSubject, true)
+	}
+	for _, path := range b {
+		c.AddPath(path.ToClipperPath(), clipper.PtClip, true)
+	}
+	solution, ok := c.Execute1(clipper.CtIntersection, clipper.PftNonZero, clipper.PftNonZero)
+	if !ok {
+		return overlap
+	}
+	for _, cpath := range solution {
+		overlap = append(overlap, FromClipperPath(cpath))
+	}
+	return overlap
+}
+
+// Check if a point is inside the area covered by a set of paths (even-odd rule).
+func PointInPaths(p Point, paths []Path) bool {
+	inside := false
+	for _, path := range paths {
+		if path.Contains(p) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Get the point on a set of paths which is closest to 'p'.
+func NearestPoint(paths []Path, p Point) Point {
+	nearest, dist := p, math.Inf(1)
+	for _, path := range paths {
+		for i := range path {
+			a, b := path[i], path[(i+1)%len(path)]
+			t := 0.0
+			if l := (b.X-a.X)*(b.X-a.X) + (b.Y-a.Y)*(b.Y-a.Y); l > 0 {
+				t = math.Max(0, math.Min(1, ((p.X-a.X)*(b.X-a.X)+(p.Y-a.Y)*(b.Y-a.Y))/l))
+			}
+			q := Point{a.X + (b.X-a.X)*t, a.Y + (b.Y-a.Y)*t}
+			if d := math.Hypot(p.X-q.X, p.Y-q.Y); d < dist {
+				nearest, dist = q, d
+			}
+		}
+	}
+	return nearest
+}
+
+// Get the convex hull of a set of points.
+func ConvexHull(ps Path) Path {
+	pts := ps.Copy()
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].X == pts[j].X {
+			return pts[i].Y < pts[j].Y
+		}
+		return pts[i].X < pts[j].X
+	})
+	if len(pts) < 3 {
+		return pts
+	}
+	cross := func(o, a, b Point) float64 {
+		return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+	}
+	hull := make(Path, 0)
+	for _, p := range pts { // lower hull
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(pts) - 2; i >= 0; i-- { // upper hull
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], pts[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, pts[i])
+	}
+	return hull[:len(hull)-1]
+}
+
 // Finalize the polygons before they go for file processing
 func (k *KAD) FinalizePolygons() {
 	has_err := false
-	corner_segments := 20
-	if k.Fillet == 0 {
-		corner_segments = 0 // square corner
-	}
+	outline := k.CaseOutline(&k.Arcs)
+	interior := k.CaseInterior(&k.Arcs)
+	k.Interior = interior
 	for _, layer := range k.Result.Plates {
 		// handle layer specific details
 		switch {
-		case layer == OPENLAYER:
-			usb_shift := k.Case.UsbLocation
-			if usb_shift < -(k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2) {
-				usb_shift = -(k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2)
-			}
-			if usb_shift > (k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2) {
-				usb_shift = k.Width/2 - k.Case.EdgeWidth - k.Case.UsbWidth/2
-			}
-			usb_width := k.Case.UsbWidth
-			if usb_width > (k.Width - 2*k.Case.EdgeWidth - 2*k.Kerf) {
-				usb_width = k.Width - 2*k.Case.EdgeWidth - 2*k.Kerf
-				usb_shift = 0
-			}
-			c := Point{k.LayoutCenter.X + usb_shift, k.DMZ + k.TopPad/2 + k.Kerf}
-			usb_pts := Path{
-				{-usb_width/2 + k.Kerf, -k.TopPad/2 - k.Kerf}, {usb_width/2 - k.Kerf, -k.TopPad/2 - k.Kerf},
-				{usb_width/2 - k.Kerf, k.TopPad/2 + k.Kerf}, {-usb_width/2 + k.Kerf, k.TopPad/2 + k.Kerf}}
-			usb_pts.Rel(c)
-			// overlap top side so it is completely removed...
-			usb_pts[0].Y -= 1
-			usb_pts[1].Y -= 1
-			k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys, usb_pts)
-
-			mid_pts := Path{
-				{-k.Width/2 + 2*k.Kerf + k.Case.LeftWidth, -k.Height/2 + 2*k.Kerf + k.Case.TopWidth},
-				{k.Width/2 - 2*k.Kerf - k.Case.RightWidth, -k.Height/2 + 2*k.Kerf + k.Case.TopWidth},
-				{k.Width/2 - 2*k.Kerf - k.Case.RightWidth, k.Height/2 - 2*k.Kerf - k.Case.BottomWidth},
-				{-k.Width/2 + 2*k.Kerf + k.Case.LeftWidth, k.Height/2 - 2*k.Kerf - k.Case.BottomWidth}}
-			mid_pts.Rel(k.CaseCenter)
-			k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys, mid_pts)
-
-		case layer == CLOSEDLAYER:
-			mid_pts := Path{
-				{-k.Width/2 + 2*k.Kerf + k.Case.LeftWidth, -k.Height/2 + 2*k.Kerf + k.Case.TopWidth},
-				{k.Width/2 - 2*k.Kerf - k.Case.RightWidth, -k.Height/2 + 2*k.Kerf + k.Case.TopWidth},
-				{k.Width/2 - 2*k.Kerf - k.Case.RightWidth, k.Height/2 - 2*k.Kerf - k.Case.BottomWidth},
-				{-k.Width/2 + 2*k.Kerf + k.Case.LeftWidth, k.Height/2 - 2*k.Kerf - k.Case.BottomWidth}}
-			mid_pts.Rel(k.CaseCenter)
-			k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys, mid_pts)
+		case layer == OPENLAYER || layer == CLOSEDLAYER:
+			k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys, interior...)
+			k.Layers[layer].EdgeCuts = append(k.Layers[layer].EdgeCuts, interior...)
 		}
 
 		// starting point for the 'keep' polygon
-		keep_poly := RoundRectanglePolygon(k.DMZ+(k.Width/2), k.DMZ+(k.Height/2),
-			k.Width, k.Height, k.Fillet, corner_segments)
-		k.Layers[layer].KeepPolys = []Path{keep_poly}
+		k.Layers[layer].KeepPolys = append([]Path{}, outline...)
 
 		// handle custom polygons added to this drawing
 		for _, cp := range k.CustomPolygons {
 			if in_strings(layer, cp.Layers) { // apply this custom polygon to this layer
 				paths := k.ParsePoints(cp.Points, cp.RelTo, true)
 				paths = append(paths, k.ParsePoints(cp.Points, cp.RelAbs, false)...)
+				if cp.Polygon == "custom-import" && (len(paths) == 0 || len(paths[0]) == 0) {
+					paths = []Path{{k.LayoutCenter}} // without points the drawing is centered on the layout
+				}
 				polygons := make([]Path, 0)
 				if len(paths) > 0 && len(paths[0]) > 0 {
 					switch cp.Polygon {
 					case "custom-circle":
 						for _, path := range paths {
 							for _, pt := range path {
-								polygons = append(polygons, CirclePolygon(pt.X, pt.Y, cp.Diameter/2, 20))
+								polygons = append(polygons, k.Arcs.Circle(pt.X, pt.Y, cp.Diameter/2, 20))
 							}
 						}
 						break
@@ -178,7 +343,14 @@ func (k *KAD) FinalizePolygons() {
 					case "custom-rounded-rectangle":
 						for _, path := range paths {
 							for _, pt := range path {
-								polygons = append(polygons, RoundRectanglePolygon(pt.X, pt.Y, cp.Width, cp.Height, cp.Radius, 20))
+								polygons = append(polygons, k.Arcs.RoundRectangle(pt.X, pt.Y, cp.Width, cp.Height, cp.Radius, 20))
+							}
+						}
+						break
+					case "custom-import":
+						for _, path := range paths {
+							for _, pt := range path {
+								polygons = append(polygons, k.Arcs.Fillet(CenterPaths(cp.Import.Paths(), pt), cp.Radius, cp.Radius)...)
 							}
 						}
 						break
@@ -205,81 +377,100 @@ func (k *KAD) FinalizePolygons() {
 			}
 		}
 
-		// union all of the cut polygons to make sure we don't have any crossing cut paths
-		if len(k.Layers[layer].CutPolys) > 0 { // union all inside
-			c := clipper.NewClipper(clipper.IoNone)
-			c.AddPath(Path{}.ToClipperPath(), clipper.PtSubject, true)
-			for _, poly := range k.Layers[layer].CutPolys {
-				c.AddPath(poly.ToClipperPath(), clipper.PtClip, true)
-			}
-			solution, ok := c.Execute1(clipper.CtUnion, clipper.PftNonZero, clipper.PftNonZero)
-			if !ok {
-				log.Printf("ERROR drawing layout: %s, %s", k.Hash, layer)
-				log.Printf("ERROR drawing inner union...\nCutPolys: %#v", k.Layers[layer].CutPolys)
-				has_err = true
-			} else {
-				cut_union := make([]Path, 0)
-				for _, cpath := range solution {
-					cut_union = append(cut_union, FromClipperPath(cpath))
-				}
-				k.Layers[layer].CutPolys = cut_union
-			}
+		if !k.ClipLayer(layer) {
+			has_err = true
 		}
+	}
 
-		// union all of the keep polygons to make sure we don't have any crossing keep paths
-		if len(k.Layers[layer].KeepPolys) > 0 { // union all inside
-			c := clipper.NewClipper(clipper.IoNone)
-			c.AddPath(Path{}.ToClipperPath(), clipper.PtSubject, true)
-			for _, poly := range k.Layers[layer].KeepPolys {
-				c.AddPath(poly.ToClipperPath(), clipper.PtClip, true)
+	if has_err {
+		log.Printf("ERROR Context (raw layout):\n%#v", k.RawLayout)
+	}
+}
+
+// Clip the 'cut' polygons out of the 'keep' polygons of a layer.
+func (k *KAD) ClipLayer(layer string) bool {
+	has_err := false
+	// union all of the cut polygons to make sure we don't have any crossing cut paths
+	if len(k.Layers[layer].CutPolys) > 0 { // union all inside
+		c := clipper.NewClipper(clipper.IoNone)
+		c.AddPath(Path{}.ToClipperPath(), clipper.PtSubject, true)
+		for _, poly := range k.Layers[layer].CutPolys {
+			c.AddPath(poly.ToClipperPath(), clipper.PtClip, true)
+		}
+		solution, ok := c.Execute1(clipper.CtUnion, clipper.PftNonZero, clipper.PftNonZero)
+		if !ok {
+			log.Printf("ERROR drawing layout: %s, %s", k.Hash, layer)
+			log.Printf("ERROR drawing inner union...\nCutPolys: %#v", k.Layers[layer].CutPolys)
+			has_err = true
+		} else {
+			cut_union := make([]Path, 0)
+			for _, cpath := range solution {
+				cut_union = append(cut_union, FromClipperPath(cpath))
 			}
-			solution, ok := c.Execute1(clipper.CtUnion, clipper.PftNonZero, clipper.PftNonZero)
-			if !ok {
-				log.Printf("ERROR drawing layout: %s, %s", k.Hash, layer)
-				log.Printf("ERROR drawing inner union...\nKeepPolys: %#v", k.Layers[layer].KeepPolys)
-				has_err = true
-			} else {
-				keep_union := make([]Path, 0)
-				for _, cpath := range solution {
-					keep_union = append(keep_union, FromClipperPath(cpath))
-				}
-				k.Layers[layer].KeepPolys = keep_union
+			k.Layers[layer].CutPolys = cut_union
+		}
+	}
+
+	// union all of the keep polygons to make sure we don't have any crossing keep paths
+	if len(k.Layers[layer].KeepPolys) > 0 { // union all inside
+		c := clipper.NewClipper(clipper.IoNone)
+		c.AddPath(Path{}.ToClipperPath(), clipper.PtSubject, true)
+		for _, poly := range k.Layers[layer].KeepPolys {
+			c.AddPath(poly.ToClipperPath(), clipper.PtClip, true)
+		}
+		solution, ok := c.Execute1(clipper.CtUnion, clipper.PftNonZero, clipper.PftNonZero)
+		if !ok {
+			log.Printf("ERROR drawing layout: %s, %s", k.Hash, layer)
+			log.Printf("ERROR drawing inner union...\nKeepPolys: %#v", k.Layers[layer].KeepPolys)
+			has_err = true
+		} else {
+			keep_union := make([]Path, 0)
+			for _, cpath := range solution {
+				keep_union = append(keep_union, FromClipperPath(cpath))
 			}
+			k.Layers[layer].KeepPolys = keep_union
 		}
+	}
 
-		// at this point we have everything we need to evaluate if any cut polygons cross the exterior keep boundary
+	// at this point we have everything we need to evaluate if any cut polygons cross the exterior keep boundary
+	k.CheckCutBounds(layer)
 
-		// get the surface areas before we 'cut' from the 'keep' paths
-		k.Result.Details[layer].Area = SurfaceArea(k.Layers[layer].KeepPolys) - SurfaceArea(k.Layers[layer].CutPolys)
+	// get the surface areas before we 'cut' from the 'keep' paths, including the kerf
+	k.Result.Details[layer].Area = SurfaceArea(OffsetPaths(k.Layers[layer].KeepPolys, k.Kerf, clipper.JtMiter)) -
+		SurfaceArea(OffsetPaths(k.Layers[layer].CutPolys, -k.Kerf, clipper.JtMiter))
 
-		// get the difference when we do the cut from keep
-		if len(k.Layers[layer].CutPolys) > 0 { // difference with cuts
-			c := clipper.NewClipper(clipper.IoNone)
-			for _, poly := range k.Layers[layer].KeepPolys {
-				c.AddPath(poly.ToClipperPath(), clipper.PtSubject, true)
-			}
-			for _, poly := range k.Layers[layer].CutPolys {
-				c.AddPath(poly.ToClipperPath(), clipper.PtClip, true)
-			}
-			solution, ok := c.Execute1(clipper.CtDifference, clipper.PftNonZero, clipper.PftNonZero)
-			if !ok {
-				log.Printf("ERROR drawing layout: %s, %s", k.Hash, layer)
-				log.Printf("ERROR drawing outer / inner difference...\nKeepPolys: %#v\nCutPolys: %#v",
-					k.Layers[layer].KeepPolys, k.Layers[layer].CutPolys)
-				has_err = true
-			} else {
-				keep_polys := make([]Path, 0)
-				for _, cpath := range solution {
-					keep_polys = append(keep_polys, FromClipperPath(cpath))
-				}
-				k.Layers[layer].KeepPolys = keep_polys
+	// get the difference when we do the cut from keep
+	if len(k.Layers[layer].CutPolys) > 0 { // difference with cuts
+		c := clipper.NewClipper(clipper.IoNone)
+		for _, poly := range k.Layers[layer].KeepPolys {
+			c.AddPath(poly.ToClipperPath(), clipper.PtSubject, true)
+		}
+		for _, poly := range k.Layers[layer].CutPolys {
+			c.AddPath(poly.ToClipperPath(), clipper.PtClip, true)
+		}
+		solution, ok := c.Execute1(clipper.CtDifference, clipper.PftNonZero, clipper.PftNonZero)
+		if !ok {
+			log.Printf("ERROR drawing layout: %s, %s", k.Hash, layer)
+			log.Printf("ERROR drawing outer / inner difference...\nKeepPolys: %#v\nCutPolys: %#v",
+				k.Layers[layer].KeepPolys, k.Layers[layer].CutPolys)
+			has_err = true
+		} else {
+			keep_polys := make([]Path, 0)
+			for _, cpath := range solution {
+				keep_polys = append(keep_polys, FromClipperPath(cpath))
 			}
+			k.Layers[layer].KeepPolys = keep_polys
 		}
 	}
 
-	if has_err {
-		log.Printf("ERROR Context (raw layout):\n%#v", k.RawLayout)
+	// compensate for the kerf once everything has been drawn, outlines grow outward and holes shrink inward
+	if k.Kerf != 0 {
+		k.Layers[layer].KeepPolys = OffsetPaths(k.Layers[layer].KeepPolys, k.Kerf, clipper.JtMiter)
+	}
+	if !k.CornerRelief(layer) {
+		has_err = true
 	}
+	return !has_err
 }
 
 // Parse the points passed in for custom polygons
@@ -0,0 +1,106 @@
+package kad
+
+import (
+	"log"
+)
+
+const (
+	PROFILE_PONOKO      = "ponoko"
+	PROFILE_EPILOG      = "epilog"
+	PROFILE_TROTEC      = "trotec"
+	PROFILE_SENDCUTSEND = "sendcutsend"
+	PROFILE_DPI         = 96.0 // default pixels per inch when the document size is in px
+	MM_PER_INCH         = 25.4
+)
+
+// Settings for the files sent to a laser cutting service or machine.
+// A named profile fills in any of the settings which are not set.
+type ExportProfile struct {
+	Name         string  `json:"name"`          // ponoko, epilog, trotec or sendcutsend
+	StrokeWidth  float64 `json:"stroke-width"`  // width of the lines in mm
+	CutColor     string  `json:"cut-color"`     // stroke color of the cuts
+	EngraveColor string  `json:"engrave-color"` // color of the engraving
+	Units        string  `json:"units"`         // units of the document size: mm, in or px
+	Dpi          float64 `json:"dpi"`           // pixels per inch when the units are px
+}
+
+// The settings each vendor expects, the drawing always stays in mm so only the document size changes.
+var EXPORT_PROFILES = map[string]ExportProfile{
+	PROFILE_PONOKO:      {StrokeWidth: 0.01, CutColor: "#0000FF", EngraveColor: "#FF0000", Units: "mm"},
+	PROFILE_EPILOG:      {StrokeWidth: 0.0254, CutColor: "#FF0000", EngraveColor: "#0000FF", Units: "in"},
+	PROFILE_TROTEC:      {StrokeWidth: 0.01, CutColor: "#FF0000", EngraveColor: "#000000", Units: "mm"},
+	PROFILE_SENDCUTSEND: {StrokeWidth: 0.1, CutColor: "#000000", EngraveColor: "#0000FF", Units: "px", Dpi: PROFILE_DPI},
+}
+
+// Apply the export profile to the line style and the colors.
+// The units of the profile only change the document size, the UOM of the design is left alone.
+func (k *KAD) ApplyProfile() {
+	p := k.Profile
+	if p == (ExportProfile{}) {
+		return
+	}
+	if p.Name != "" {
+		named, ok := EXPORT_PROFILES[p.Name]
+		if !ok {
+			log.Printf("ERROR unknown export profile, the settings are left unchanged: %s", p.Name)
+			k.Profile = ExportProfile{}
+			return
+		}
+		if p.StrokeWidth <= 0 {
+			p.StrokeWidth = named.StrokeWidth
+		}
+		if p.CutColor == "" {
+			p.CutColor = named.CutColor
+		}
+		if p.EngraveColor == "" {
+			p.EngraveColor = named.EngraveColor
+		}
+		if p.Units == "" {
+			p.Units = named.Units
+		}
+		if p.Dpi <= 0 {
+			p.Dpi = named.Dpi
+		}
+	}
+	switch p.Units {
+	case "", "mm", "in":
+	case "px":
+		if p.Dpi <= 0 {
+			p.Dpi = PROFILE_DPI
+		}
+	default:
+		log.Printf("ERROR unknown export profile units, the document is sized in %s: %s", k.UOM, p.Units)
+		p.Units = ""
+	}
+
+	if p.StrokeWidth > 0 {
+		k.LineWeight = p.StrokeWidth
+	}
+	if p.CutColor != "" {
+		k.LineColor = p.CutColor
+		k.Engraving.CutColor = p.CutColor
+	}
+	if p.EngraveColor != "" {
+		k.Engraving.Color = p.EngraveColor
+	}
+	if k.SvgStyle == "" {
+		k.SvgStyle = "fill:none" // only the outlines, so nothing is read as a raster engraving
+	}
+	k.Profile = p
+}
+
+// Get the size of a document in the units of the export profile from its size in mm.
+func (k *KAD) DocumentSize(width, height float64) (float64, float64, string) {
+	switch k.Profile.Units {
+	case "in":
+		return width / MM_PER_INCH, height / MM_PER_INCH, k.Profile.Units
+	case "px":
+		dpi := k.Profile.Dpi
+		if dpi <= 0 {
+			dpi = PROFILE_DPI
+		}
+		return width / MM_PER_INCH * dpi, height / MM_PER_INCH * dpi, k.Profile.Units
+	default:
+		return width, height, k.UOM
+	}
+}
@@ -0,0 +1,207 @@
+package kad
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	PREVIEW_DPI     = 96.0
+	PREVIEW_OPACITY = 0.6       // default opacity of each layer in the stacked preview
+	PREVIEW_COLOR   = "#607080" // default fill color for the layers without a color
+	PREVIEW_STACK   = "stack"   // name used for the stacked preview
+	RASTER_SAMPLES  = 4         // sub scanlines per pixel row, for anti-aliasing
+)
+
+// Default fill colors of the layers in the previews.
+var PREVIEW_COLORS = map[string]string{
+	BOTTOMLAYER: "#4a4a4a",
+	CLOSEDLAYER: "#8c6d46",
+	OPENLAYER:   "#b08d57",
+	SWITCHLAYER: "#9aa5b1",
+	TOPLAYER:    "#d0d5db",
+	WRISTLAYER:  "#8c6d46",
+}
+
+// Settings for the png previews of the plates.
+type PngPreview struct {
+	Dpi     float64           `json:"dpi"`     // resolution of the previews, defaults to 96
+	Colors  map[string]string `json:"colors"`  // fill color of each layer as '#rrggbb'
+	Opacity float64           `json:"opacity"` // opacity of each layer in the stacked preview, between 0 and 1
+}
+
+// Get the resolution of the previews in pixels per mm.
+func (k *KAD) PreviewScale() float64 {
+	if k.Preview.Dpi > 0 {
+		return k.Preview.Dpi / 25.4
+	}
+	return PREVIEW_DPI / 25.4
+}
+
+// Get the fill color of a layer in the previews.
+func (k *KAD) PreviewColor(layer string) color.NRGBA {
+	name := layer
+	if strings.HasPrefix(layer, WRISTLAYER) { // the wrist rest layers are numbered when there is more than one
+		name = WRISTLAYER
+	}
+	for _, hex := range []string{k.Preview.Colors[layer], k.Preview.Colors[name], PREVIEW_COLORS[name]} {
+		if c, ok := ParseHexColor(hex); ok {
+			return c
+		}
+	}
+	c, _ := ParseHexColor(PREVIEW_COLOR)
+	return c
+}
+
+// Parse a '#rrggbb' color.
+func ParseHexColor(s string) (color.NRGBA, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) != 6 {
+		return color.NRGBA{}, false
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	return color.NRGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 255}, true
+}
+
+// Get how much of each pixel is covered by the paths (even-odd rule), from 0 to 1.
+// The paths are in mm and are scaled to pixels by 'scale'.
+func RasterizePaths(paths []Path, scale float64, width, height int) []float64 {
+	cov := make([]float64, width*height)
+	type edge struct{ x0, y0, x1, y1 float64 }
+	rows := make([][]edge, height*RASTER_SAMPLES) // the edges by the first sub scanline they cross
+	for _, path := range paths {
+		for i := range path {
+			a, b := path[i], path[(i+1)%len(path)]
+			e := edge{a.X * scale, a.Y * scale * RASTER_SAMPLES, b.X * scale, b.Y * scale * RASTER_SAMPLES}
+			if e.y0 == e.y1 {
+				continue
+			}
+			if e.y0 > e.y1 {
+				e = edge{e.x1, e.y1, e.x0, e.y0}
+			}
+			// the sub scanlines are sampled at their centers
+			first := int(math.Max(math.Ceil(e.y0-0.5), 0))
+			if first < len(rows) && float64(first)+0.5 < e.y1 {
+				rows[first] = append(rows[first], e)
+			}
+		}
+	}
+
+	active := make([]edge, 0)
+	xs := make([]float64, 0)
+	for s := range rows {
+		y := float64(s) + 0.5
+		active = append(active, rows[s]...)
+		xs = xs[:0]
+		kept := active[:0]
+		for _, e := range active {
+			if y >= e.y1 {
+				continue // the edge ends above this scanline
+			}
+			kept = append(kept, e)
+			xs = append(xs, e.x0+(y-e.y0)*(e.x1-e.x0)/(e.y1-e.y0))
+		}
+		active = kept
+		sort.Float64s(xs)
+		row := (s / RASTER_SAMPLES) * width
+		for i := 0; i+1 < len(xs); i += 2 {
+			xa, xb := math.Max(xs[i], 0), math.Min(xs[i+1], float64(width))
+			if xb <= xa {
+				continue
+			}
+			ia, ib := int(xa), int(xb)
+			if ia == ib {
+				cov[row+ia] += (xb - xa) / RASTER_SAMPLES
+				continue
+			}
+			cov[row+ia] += (float64(ia+1) - xa) / RASTER_SAMPLES
+			for p := ia + 1; p < ib; p++ {
+				cov[row+p] += 1.0 / RASTER_SAMPLES
+			}
+			if ib < width {
+				cov[row+ib] += (xb - float64(ib)) / RASTER_SAMPLES
+			}
+		}
+	}
+	return cov
+}
+
+// Paint the layers onto a white image, each one over the last with the 'opacity'.
+func (k *KAD) RenderLayers(layers []string, opacity float64) *image.NRGBA {
+	scale := k.PreviewScale()
+	w, h := 0.0, 0.0
+	for _, layer := range layers {
+		w = math.Max(w, k.Layers[layer].Width+2*k.DMZ)
+		h = math.Max(h, k.Layers[layer].Height+2*k.DMZ)
+	}
+	width, height := int(math.Ceil(w*scale)), int(math.Ceil(h*scale))
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+	for _, layer := range layers {
+		c := k.PreviewColor(layer)
+		cov := RasterizePaths(k.Layers[layer].KeepPolys, scale, width, height)
+		for p, v := range cov {
+			a := math.Min(v, 1) * opacity
+			if a <= 0 {
+				continue
+			}
+			for j, channel := range []uint8{c.R, c.G, c.B} {
+				old := float64(img.Pix[p*4+j])
+				img.Pix[p*4+j] = uint8(math.Round(old*(1-a) + float64(channel)*a))
+			}
+		}
+	}
+	return img
+}
+
+// Write the layers to a png file.
+func (k *KAD) WritePng(path string, layers []string, opacity float64) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return png.Encode(file, k.RenderLayers(layers, opacity))
+}
+
+// Write the preview of the layers stacked into the case, seen from above.
+func (k *KAD) WriteStackPreview(abs_base string) {
+	if !in_strings("png", k.Result.Formats) {
+		return
+	}
+	layers := make([]string, 0)
+	// a layer which is split into pieces is not a plate any more, so it is left out (SegmentPlates warns about it)
+	for _, layer := range MODEL_STACK {
+		if in_strings(layer, k.Result.Plates) {
+			layers = append(layers, layer)
+		}
+	}
+	if len(layers) == 0 {
+		return
+	}
+	opacity := k.Preview.Opacity
+	if opacity <= 0 || opacity > 1 {
+		opacity = PREVIEW_OPACITY
+	}
+	if err := k.WritePng(fmt.Sprintf("%s_%s.png", abs_base, PREVIEW_STACK), layers, opacity); err != nil {
+		log.Printf("ERROR: could not create the stacked preview for: %s | %s", k.Hash, err.Error())
+		return
+	}
+	k.Result.Previews = append(k.Result.Previews, Export{
+		Ext: "png",
+		Url: fmt.Sprintf("%s%s_%s.png", k.FileServePath, k.Hash, PREVIEW_STACK),
+	})
+}
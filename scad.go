@@ -0,0 +1,104 @@
+package kad
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var scad_name = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Get the name of a layer which can be used in OpenSCAD identifiers.
+func ScadName(layer string) string {
+	return scad_name.ReplaceAllString(strings.ToLower(layer), "_")
+}
+
+// Write a layer as an OpenSCAD module which extrudes the polygons by the thickness.
+// The file renders the layer when opened on its own, and the module can be pulled into other files with 'use'.
+func (k *KAD) WriteScad(layer string, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	height := k.Layers[layer].Height + 2*k.DMZ // openscad uses a y axis which points up
+	module := ScadName(layer)
+	thickness := k.LayerThickness(layer)
+	name := layer
+	if d, ok := k.Result.Details[layer]; ok {
+		name = d.Name
+	}
+
+	fmt.Fprintf(w, "// %s %s\n// units are mm, with the y axis pointing up\n\n", k.Hash, name)
+	fmt.Fprintf(w, "%s_thickness = %.3f;\n\n", module, thickness)
+	fmt.Fprintf(w, "module %s_layer(thickness = %.3f) {\n\tlinear_extrude(height = thickness)\n\t\tpolygon(points = [\n", module, thickness)
+	paths := make([]string, 0)
+	n := 0
+	for _, poly := range k.Layers[layer].KeepPolys {
+		if len(poly) < 3 {
+			continue
+		}
+		idx := make([]string, len(poly))
+		for i, p := range poly {
+			fmt.Fprintf(w, "\t\t\t[%.4f, %.4f],\n", p.X, height-p.Y)
+			idx[i] = fmt.Sprintf("%d", n+i)
+		}
+		n += len(poly)
+		paths = append(paths, fmt.Sprintf("\t\t\t[%s]", strings.Join(idx, ", ")))
+	}
+	fmt.Fprintf(w, "\t\t], paths = [\n%s\n\t\t]);\n}\n\n", strings.Join(paths, ",\n"))
+	fmt.Fprintf(w, "%s_layer(%s_thickness);\n", module, module)
+	return w.Flush()
+}
+
+// Write the OpenSCAD assembly of the layers stacked into the case, with the switch centers as named variables.
+// The layer files are expected to be next to the assembly file.
+func (k *KAD) WriteScadCase(path string, layers []string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	height := k.Layers[layers[0]].Height + 2*k.DMZ // openscad uses a y axis which points up
+
+	fmt.Fprintf(w, "// %s case assembly\n// units are mm, with the y axis pointing up\n\n", k.Hash)
+	for _, layer := range layers {
+		fmt.Fprintf(w, "use <%s>\n", filepath.Base(fmt.Sprintf("%s%s_%s.scad", k.FileDirectory, k.Hash, layer)))
+	}
+	fmt.Fprintf(w, "\n// thickness of the layers\n")
+	for _, layer := range layers {
+		fmt.Fprintf(w, "%s_thickness = %.3f;\n", ScadName(layer), k.LayerThickness(layer))
+	}
+
+	fmt.Fprintf(w, "\n// switch centers as [x, y], named by row and column\n")
+	keys := make([]string, 0)
+	for r, row := range k.Layout {
+		for c, key := range row {
+			name := fmt.Sprintf("key_%d_%d", r+1, c+1)
+			comment := ""
+			if key.Label != "" {
+				comment = fmt.Sprintf(" // %s", key.Label)
+			}
+			fmt.Fprintf(w, "%s = [%.4f, %.4f];%s\n", name, key.Center.X, height-key.Center.Y, comment)
+			keys = append(keys, name)
+		}
+	}
+	fmt.Fprintf(w, "keys = [%s];\n\n", strings.Join(keys, ", "))
+
+	// stack the layers from the bottom up
+	z := make([]string, 0)
+	for _, layer := range layers {
+		offset := "0"
+		if len(z) > 0 {
+			offset = strings.Join(z, " + ")
+		}
+		fmt.Fprintf(w, "translate([0, 0, %s]) %s_layer(%s_thickness);\n", offset, ScadName(layer), ScadName(layer))
+		z = append(z, ScadName(layer)+"_thickness")
+	}
+	return w.Flush()
+}
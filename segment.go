@@ -0,0 +1,271 @@
+package kad
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	JOINT_PUZZLE     = "puzzle"
+	JOINT_DOVETAIL   = "dovetail"
+	JOINT_SIZE       = 6.0 // default size of the joints in mm
+	SEGMENT_STEP     = 0.5 // step in mm when searching for a seam or joint position
+	SEGMENT_GAP      = 1.0 // min material in mm between a seam or joint and a cutout
+	JOINT_SPACING    = 8.0 // add another joint for every 'JOINT_SPACING' joint sizes of seam
+	SEGMENT_UNSAFE   = "segment-unsafe"
+	SEGMENT_NO_JOINT = "segment-no-joint"
+	SEGMENT_STACK    = "segment-stack"
+)
+
+// Split plates which are larger than the max size into pieces which are joined at the seams.
+type Segmentation struct {
+	MaxWidth  float64 `json:"max-width"`  // max width of a piece in mm
+	MaxHeight float64 `json:"max-height"` // max height of a piece in mm
+	Joint     string  `json:"joint"`      // 'puzzle' (default) or 'dovetail'
+	JointSize float64 `json:"joint-size"` // size of the joints in mm
+}
+
+// Split the plates which are too large into pieces, each piece replaces the plate as a new plate.
+// The pieces are not assembled into the case model or the stacked preview, so a split layer of the case is left out of them.
+func (k *KAD) SegmentPlates() {
+	s := k.Segmentation
+	if s.MaxWidth <= 0 && s.MaxHeight <= 0 {
+		return
+	}
+	if s.JointSize <= 0 {
+		s.JointSize = JOINT_SIZE
+	}
+	plates := make([]string, 0)
+	for _, layer := range k.Result.Plates {
+		pieces := [][]Path{k.Layers[layer].KeepPolys}
+		if s.MaxWidth > 0 {
+			pieces = k.SplitPieces(layer, pieces, s.MaxWidth, false)
+		}
+		if s.MaxHeight > 0 {
+			pieces = k.SplitPieces(layer, pieces, s.MaxHeight, true)
+		}
+		if len(pieces) == 1 {
+			plates = append(plates, layer)
+			continue
+		}
+		if in_strings(layer, MODEL_STACK) && k.StackOutputs() {
+			k.Result.Warnings = append(k.Result.Warnings, Warning{
+				Code:    SEGMENT_STACK,
+				Layer:   layer,
+				Message: "The plate is split into pieces, so it is left out of the case model and the stacked preview.",
+			})
+		}
+		for i, piece := range pieces {
+			name := fmt.Sprintf("%s_%d", layer, i+1)
+			pts := make(Path, 0)
+			area := 0.0
+			for _, path := range piece {
+				pts = append(pts, path...)
+				area += path.SignedArea()
+			}
+			b := pts.Bounds()
+			labels := make([]Label, 0)
+			for _, l := range k.Layers[layer].Labels { // keep the text which is on this piece
+				if PointInPaths(l.At, piece) {
+					l.At = Point{l.At.X + k.DMZ - b.Xmin, l.At.Y + k.DMZ - b.Ymin}
+					labels = append(labels, l)
+				}
+			}
+			for _, path := range piece { // move the piece to the top left of its own canvas
+				path.Rel(Point{k.DMZ - b.Xmin, k.DMZ - b.Ymin})
+			}
+			arcs := &ArcIndex{Kerf: k.Kerf}
+			arcs.Transform(k.LayerArcs(layer), func(pt Point) Point { return Point{pt.X + k.DMZ - b.Xmin, pt.Y + k.DMZ - b.Ymin} })
+			k.Layers[name] = &Layer{KeepPolys: piece, Labels: labels, Arcs: arcs, Width: b.Xmax - b.Xmin, Height: b.Ymax - b.Ymin}
+			k.CornerRelief(name) // relieve the new corners of the seams and joints
+			k.Result.Details[name] = &ResultDetails{
+				Name:   fmt.Sprintf("%s %d", k.Result.Details[layer].Name, i+1),
+				Width:  b.Xmax - b.Xmin,
+				Height: b.Ymax - b.Ymin,
+				Area:   math.Abs(area),
+			}
+			plates = append(plates, name)
+		}
+		delete(k.Result.Details, layer)
+	}
+	k.Result.Plates = plates
+}
+
+// Split each of the pieces along vertical seams (or horizontal seams if 'transpose') until they fit in 'max'.
+func (k *KAD) SplitPieces(layer string, pieces [][]Path, max float64, transpose bool) [][]Path {
+	size := k.Segmentation.JointSize
+	if size <= 0 {
+		size = JOINT_SIZE
+	}
+	split := make([][]Path, 0)
+	for _, piece := range pieces {
+		if transpose {
+			piece = TransposePaths(piece)
+		}
+		pts := make(Path, 0)
+		for _, path := range piece {
+			pts = append(pts, path...)
+		}
+		b := pts.Bounds()
+		if b.Xmax-b.Xmin <= max {
+			if transpose {
+				piece = TransposePaths(piece)
+			}
+			split = append(split, piece)
+			continue
+		}
+
+		// the seams have to stay clear of the holes which are smaller than a piece
+		sign := OuterSign(piece)
+		blocked := make([][2]float64, 0)
+		for _, path := range piece {
+			if pb := path.Bounds(); path.SignedArea()*sign < 0 && pb.Xmax-pb.Xmin < max {
+				blocked = append(blocked, [2]float64{pb.Xmin - SEGMENT_GAP, pb.Xmax + SEGMENT_GAP})
+			}
+		}
+		free := func(x float64) bool {
+			for _, iv := range blocked {
+				if x > iv[0] && x < iv[1] {
+					return false
+				}
+			}
+			return true
+		}
+
+		// place the seams as evenly as possible, the joints stick out into the next piece
+		edges := []float64{b.Xmin - 1}
+		prev := b.Xmin
+		for b.Xmax-prev > max {
+			target := prev + (b.Xmax-prev)/math.Ceil((b.Xmax-prev)/(max-size))
+			seam := math.NaN()
+			for x := prev + 2*size; x <= prev+max-size; x += SEGMENT_STEP {
+				if free(x) && (math.IsNaN(seam) || math.Abs(x-target) < math.Abs(seam-target)) {
+					seam = x
+				}
+			}
+			if math.IsNaN(seam) {
+				seam = target
+				k.Result.Warnings = append(k.Result.Warnings, Warning{
+					Code:     SEGMENT_UNSAFE,
+					Layer:    layer,
+					Message:  "No seam could be found which avoids the cutouts.",
+					Location: SeamLocation(seam, b, transpose),
+				})
+			}
+			edges = append(edges, seam)
+			prev = seam
+		}
+		edges = append(edges, b.Xmax+1)
+
+		// the joints of each seam stick out of the piece to the left of the seam
+		joints := make([][]Path, len(edges))
+		for i := 1; i < len(edges)-1; i++ {
+			joints[i] = JointPaths(piece, edges[i], size, k.Segmentation.Joint)
+			if len(joints[i]) == 0 {
+				k.Result.Warnings = append(k.Result.Warnings, Warning{
+					Code:     SEGMENT_NO_JOINT,
+					Layer:    layer,
+					Message:  "There is no room for a joint along the seam.",
+					Location: SeamLocation(edges[i], b, transpose),
+				})
+			}
+		}
+		for i := 0; i < len(edges)-1; i++ {
+			region := []Path{{{edges[i], b.Ymin - 1}, {edges[i+1], b.Ymin - 1}, {edges[i+1], b.Ymax + 1}, {edges[i], b.Ymax + 1}}}
+			region, _ = DifferencePaths(region, joints[i])
+			region, _ = UnionPaths(append(region, joints[i+1]...))
+			part := IntersectPaths(piece, OffsetPaths(region, k.Kerf, clipper.JtMiter))
+			if transpose {
+				part = TransposePaths(part)
+			}
+			if len(part) > 0 {
+				split = append(split, part)
+			}
+		}
+	}
+	return split
+}
+
+// Get the joints along a vertical seam at 'x', spread along each span of material the seam crosses.
+// Each joint is moved along the span until it is clear of the cutouts.
+func JointPaths(paths []Path, x, size float64, joint string) []Path {
+	shape := func(y float64) []Path {
+		switch joint {
+		case JOINT_DOVETAIL:
+			return []Path{{{x - 0.01, y - size*0.3}, {x + size, y - size*0.5}, {x + size, y + size*0.5}, {x - 0.01, y + size*0.3}}}
+		default: // a round head on a narrow neck
+			neck := Path{{x - 0.01, y - size*0.2}, {x + size*0.6, y - size*0.2}, {x + size*0.6, y + size*0.2}, {x - 0.01, y + size*0.2}}
+			union, _ := UnionPaths([]Path{neck, CirclePolygon(x+size*0.6, y, size*0.4, 5)})
+			return union
+		}
+	}
+	clear := func(y float64) bool {
+		outside, ok := DifferencePaths(OffsetPaths(shape(y), SEGMENT_GAP, clipper.JtMiter), paths)
+		return ok && len(outside) == 0
+	}
+
+	joints := make([]Path, 0)
+	for _, sp := range LineSpans(paths, x) {
+		span := sp[1] - sp[0]
+		count := int(span/(JOINT_SPACING*size)) + 1
+		for j := 0; j < count; j++ {
+			// search out from the middle of this part of the span
+			lo, hi := sp[0]+span*float64(j)/float64(count), sp[0]+span*float64(j+1)/float64(count)
+			mid := (lo + hi) / 2
+			for d := 0.0; d <= (hi-lo)/2; d += SEGMENT_STEP {
+				if y := mid - d; clear(y) {
+					joints = append(joints, shape(y)...)
+					break
+				}
+				if y := mid + d; clear(y) {
+					joints = append(joints, shape(y)...)
+					break
+				}
+			}
+		}
+	}
+	return joints
+}
+
+// Get the spans (from low to high y) where a vertical line at 'x' is inside the paths (even-odd rule).
+func LineSpans(paths []Path, x float64) [][2]float64 {
+	ys := make([]float64, 0)
+	for _, path := range paths {
+		for i := range path {
+			a, b := path[i], path[(i+1)%len(path)]
+			if (a.X <= x) != (b.X <= x) {
+				ys = append(ys, a.Y+(x-a.X)*(b.Y-a.Y)/(b.X-a.X))
+			}
+		}
+	}
+	sort.Float64s(ys)
+	spans := make([][2]float64, 0)
+	for i := 0; i+1 < len(ys); i += 2 {
+		spans = append(spans, [2]float64{ys[i], ys[i+1]})
+	}
+	return spans
+}
+
+// Get the middle of a seam at 'x' across the bounds, in the coordinates of the layer.
+func SeamLocation(x float64, b Bounds, transpose bool) Point {
+	if transpose {
+		return Point{(b.Ymin + b.Ymax) / 2, x}
+	}
+	return Point{x, (b.Ymin + b.Ymax) / 2}
+}
+
+// Swap the x and y coordinates of the paths.
+func TransposePaths(paths []Path) []Path {
+	transposed := make([]Path, 0)
+	for _, path := range paths {
+		t := make(Path, len(path))
+		for i, pt := range path {
+			t[i] = Point{pt.Y, pt.X}
+		}
+		transposed = append(transposed, t)
+	}
+	return transposed
+}
@@ -0,0 +1,126 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestContour(t *testing.T) {
+	arcs := func(c kad.Contour) (n int) {
+		for _, s := range c.Segments {
+			if s.Radius > 0 {
+				n++
+			}
+		}
+		return n
+	}
+
+	index := &kad.ArcIndex{}
+
+	circle := index.Circle(10, 10, 3, 5).Contour(index)
+	if len(circle.Segments) != 2 || arcs(circle) != 2 {
+		t.Errorf("TestContour: expected a circle to become 2 arcs, got %d segments", len(circle.Segments))
+	}
+	if r := circle.Segments[0].Radius; r != 3 {
+		t.Errorf("TestContour: expected a radius of 3, got %f", r)
+	}
+	if l := circle.Length(); l < 18.84 || l > 18.86 {
+		t.Errorf("TestContour: expected a circumference of 18.85, got %f", l)
+	}
+
+	rect := index.RoundRectangle(0, 0, 40, 20, 4, 5).Contour(index)
+	if len(rect.Segments) != 8 || arcs(rect) != 4 {
+		t.Errorf("TestContour: expected a rounded rectangle to have 4 lines and 4 arcs, got %d segments with %d arcs",
+			len(rect.Segments), arcs(rect))
+	}
+
+	// curves which were not drawn as arcs keep their straight edges
+	gon := kad.Path{}
+	for i := 0; i < 12; i++ {
+		a := 2 * math.Pi * float64(i) / 12
+		gon = append(gon, kad.Point{X: 50 + 10*math.Cos(a), Y: 10 * math.Sin(a)})
+	}
+	if c := gon.Contour(index); len(c.Segments) != 12 || arcs(c) != 0 {
+		t.Errorf("TestContour: expected a 12-gon to have 12 lines, got %d segments with %d arcs", len(c.Segments), arcs(c))
+	}
+	if c := kad.CirclePolygon(80, 80, 3, 5).Contour(index); arcs(c) != 0 {
+		t.Errorf("TestContour: expected a circle which is not in the index to have no arcs, got %d", arcs(c))
+	}
+
+	// a straight edge which happens to be a chord of one step of an arc is not turned into an arc
+	index.Circle(0, 100, 10, 5)
+	chord := kad.Path{{X: 10, Y: 100}, {X: 10 * math.Cos(0.3), Y: 100 + 10*math.Sin(0.3)}, {X: 20, Y: 120}}.Contour(index)
+	if len(chord.Segments) != 3 || arcs(chord) != 0 {
+		t.Errorf("TestContour: expected a chord of a circle to stay 3 lines, got %d segments with %d arcs",
+			len(chord.Segments), arcs(chord))
+	}
+
+	square := kad.Path{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}}.Contour(index)
+	if len(square.Segments) != 4 || arcs(square) != 0 {
+		t.Errorf("TestContour: expected a square to have 4 lines, got %d segments with %d arcs",
+			len(square.Segments), arcs(square))
+	}
+
+	// a hole clipped by a straight cut keeps the cut straight, along with the partial steps on either side of it
+	clipped, _ := kad.DifferencePaths([]kad.Path{index.Circle(100, 0, 5, 5)},
+		[]kad.Path{{{X: 103, Y: -10}, {X: 110, Y: -10}, {X: 110, Y: 10}, {X: 103, Y: 10}}})
+	if c := clipped[0].Contour(index); arcs(c) == 0 || len(c.Segments) != arcs(c)+3 {
+		t.Errorf("TestContour: expected a clipped circle to be arcs and 3 lines, got %d segments with %d arcs", len(c.Segments), arcs(c))
+	}
+}
+
+func TestTrueArcs(t *testing.T) {
+	json_str := `{
+		"layout":[
+			["","","",""],
+			["","","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6
+		},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3,
+		"true-arcs":true
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "dxf"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestTrueArcs: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "true_arcs"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestTrueArcs: failed to Draw the KAD file")
+		return
+	}
+
+	svg, err := os.ReadFile("./output/true_arcs_top.svg")
+	if err != nil || !strings.Contains(string(svg), " A") {
+		t.Errorf("TestTrueArcs: expected the top layer svg to contain arcs")
+	}
+	dxf, err := os.ReadFile("./output/true_arcs_top.dxf")
+	if err != nil || !strings.Contains(string(dxf), "\n42\n") {
+		t.Errorf("TestTrueArcs: expected the top layer dxf to contain bulges")
+	}
+}
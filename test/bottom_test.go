@@ -0,0 +1,151 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestBottomFeatures(t *testing.T) {
+	json_str := `{
+		"layout":[
+			["","","","","",""],
+			["","","","","",""],
+			["","","","","",""],
+			["","","","","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6,
+			"bottom-features":[
+				{"type":"foot"},
+				{"type":"foot", "rel_to":"center", "points":"[0,-y+12];[0,y-12]", "marker":true},
+				{"type":"tent"},
+				{"type":"kickstand", "diameter":8}
+			]
+		},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestBottomFeatures: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "bottom_features"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestBottomFeatures: failed to Draw the KAD file")
+		return
+	}
+
+	// the features are holes through the bottom layer: 4 feet, 2 markers, 4 tent holes and 2 kickstands
+	holes := feature_holes(cad)
+	if len(holes) != 4+2+4+2 {
+		t.Errorf("TestBottomFeatures: expected 12 feature holes, got %d", len(holes))
+	}
+	left, right := cad.DMZ, cad.DMZ+cad.Width
+	top, bottom := cad.DMZ, cad.DMZ+cad.Height
+	c := cad.CaseCenter
+	expected := []struct {
+		name     string
+		at       kad.Point
+		diameter float64
+	}{
+		{"foot", kad.Point{X: left + 12, Y: top + 12}, 10},
+		{"foot", kad.Point{X: right - 12, Y: bottom - 12}, 10},
+		{"foot marker", kad.Point{X: c.X, Y: top + 12}, 1},
+		{"tent hole", kad.Point{X: c.X - 10, Y: c.Y + 10}, 5.2},
+		{"kickstand", kad.Point{X: c.X - cad.Width/4, Y: top + 12}, 8},
+	}
+	for _, e := range expected {
+		if d, ok := holes[feature_key(e.at)]; !ok || math.Abs(d-e.diameter) > 0.01 {
+			t.Errorf("TestBottomFeatures: expected a %.1fmm %s hole at %v, got %v", e.diameter, e.name, e.at, holes)
+		}
+	}
+
+	// the feet in the corners stay inside an outline which hugs the keys
+	json_str = `{
+		"layout":[
+			["","","",""],
+			[{"x":1},"","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"bottom-features":[{"type":"foot"}]
+		},
+		"outline":"layout",
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad = kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder = json.NewDecoder(strings.NewReader(json_str))
+	err = decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestBottomFeatures: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "bottom_features_layout"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestBottomFeatures: failed to Draw the KAD file")
+		return
+	}
+	holes = feature_holes(cad)
+	if len(holes) != 4 {
+		t.Errorf("TestBottomFeatures: expected 4 feet inside the layout outline, got %d", len(holes))
+	}
+	key := cad.Layout[1][0].Bounds.Bounds()
+	at := kad.Point{X: key.Xmin - 9 + 12, Y: key.Ymax + 9 - 12}
+	if _, ok := holes[feature_key(at)]; !ok {
+		t.Errorf("TestBottomFeatures: expected the bottom left foot in the corner of the second row at %v, got %v", at, holes)
+	}
+}
+
+// Get the diameter of each hole in the bottom layer by its center, without the outline and the mount holes.
+func feature_holes(cad *kad.KAD) map[[2]int]float64 {
+	holes := make(map[[2]int]float64)
+	for _, poly := range cad.Layers[kad.BOTTOMLAYER].KeepPolys {
+		b := poly.Bounds()
+		if b.Xmax-b.Xmin < cad.Width/2 {
+			holes[feature_key(kad.Point{X: (b.Xmin + b.Xmax) / 2, Y: (b.Ymin + b.Ymax) / 2})] = b.Xmax - b.Xmin
+		}
+	}
+	for _, h := range cad.MountHoles {
+		delete(holes, feature_key(h))
+	}
+	return holes
+}
+
+// Round a point to 0.1mm so it can be looked up.
+func feature_key(p kad.Point) [2]int {
+	return [2]int{int(math.Round(p.X * 10)), int(math.Round(p.Y * 10))}
+}
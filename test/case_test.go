@@ -0,0 +1,219 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+
+	clipper "github.com/swill/go.clipper"
+	"github.com/swill/kad"
+)
+
+func TestLayoutOutline(t *testing.T) {
+	json_str := `{
+		"layout":[
+			[{"r":10,"rx":1,"y":-0.1,"x":2},"",""],
+			[{"x":1.5},"","",""],
+			[{"x":1.25},"","",""],
+			[{"r":-10,"rx":7,"ry":0,"y":-0.1,"x":-1},"",""],
+			[{"x":-1.5},"","",""],
+			[{"x":-1.25},"","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-edge":6
+		},
+		"outline":"layout",
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestLayoutOutline: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "layout_outline"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestLayoutOutline: failed to Draw the KAD file")
+		return
+	}
+
+	// the outline is the key bounds grown by the padding, moved to the corner of the canvas
+	keys := kad.Path{}
+	for _, row := range cad.Layout {
+		for _, key := range row {
+			keys = append(keys, key.Bounds...)
+		}
+	}
+	kb := keys.Bounds()
+	// the largest path of a layer is its outline, and the next largest is the opening of the middle layers
+	largest := func(layer string, n int) kad.Path {
+		polys := append([]kad.Path{}, cad.Layers[layer].KeepPolys...)
+		sort.Slice(polys, func(i, j int) bool {
+			return math.Abs(clipper.Area(polys[i].ToClipperPath())) > math.Abs(clipper.Area(polys[j].ToClipperPath()))
+		})
+		return polys[n]
+	}
+	near := func(a, b kad.Bounds, pad float64) bool {
+		return math.Abs(a.Xmin-(b.Xmin-pad)) < 0.01 && math.Abs(a.Ymin-(b.Ymin-pad)) < 0.01 &&
+			math.Abs(a.Xmax-(b.Xmax+pad)) < 0.01 && math.Abs(a.Ymax-(b.Ymax+pad)) < 0.01
+	}
+	outline := largest(kad.BOTTOMLAYER, 0).Bounds()
+	if !near(outline, kb, 9) {
+		t.Errorf("TestLayoutOutline: expected the outline %v to be the keys %v grown by 9", outline, kb)
+	}
+	if math.Abs(outline.Xmin-cad.DMZ) > 0.01 || math.Abs(outline.Ymin-cad.DMZ) > 0.01 {
+		t.Errorf("TestLayoutOutline: expected the outline to start at the DMZ, got %v", outline)
+	}
+	// the opening of the closed layer leaves the case edge inside the padding
+	edge := cad.Case.LeftWidth
+	if opening := largest(kad.CLOSEDLAYER, 1).Bounds(); !near(opening, kb, 9-edge) {
+		t.Errorf("TestLayoutOutline: expected the opening %v to be the keys %v grown by %g", opening, kb, 9-edge)
+	}
+	// hugging the keys leaves out the corners of the rectangle around them
+	area := math.Abs(clipper.Area(largest(kad.BOTTOMLAYER, 0).ToClipperPath())) / (kad.PRECISION * kad.PRECISION)
+	if rect := (outline.Xmax - outline.Xmin) * (outline.Ymax - outline.Ymin); area > 0.9*rect {
+		t.Errorf("TestLayoutOutline: expected the outline to be smaller than its bounds, got %.1f of %.1f", area, rect)
+	}
+}
+
+func TestConnectors(t *testing.T) {
+	json_str := `{
+		"layout":[
+			["","","","",""],
+			["","","","",""],
+			["","","","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-edge":6,
+			"connectors":[
+				{"edge":"left", "offset":-10, "width":9.5, "radius":1.5, "layers":["open","closed"]},
+				{"edge":"right", "offset":10, "width":6.5, "depth":12},
+				{"edge":"top", "offset":200, "width":12, "radius":3},
+				{"edge":"bottom", "width":20, "depth":4, "radius":2, "layers":["bottom"]}
+			]
+		},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestConnectors: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "connectors"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestConnectors: failed to Draw the KAD file")
+		return
+	}
+
+	left, right := cad.DMZ, cad.DMZ+cad.Width
+	top, bottom := cad.DMZ, cad.DMZ+cad.Height
+	c := cad.LayoutCenter
+	checks := []struct {
+		name     string
+		layer    string
+		x, y     float64
+		material bool
+	}{
+		{"left opening", "open", left + 3, c.Y - 10, false},
+		{"left opening", "closed", left + 3, c.Y - 10, false},
+		{"beside the left opening", "closed", left + 3, c.Y - 10 + 6, true},
+		{"right opening", "open", right - 11, c.Y + 10, false},
+		{"beside the right opening", "open", right - 3, c.Y + 10 + 4.5, true},
+		{"top opening at the end of the edge", "open", right - 6 - 6, top + 3, false},
+		{"beside the top opening", "open", right - 6 - 12 - 1, top + 3, true},
+		{"bottom opening", "bottom", c.X, bottom - 2, false},
+		{"past the bottom opening", "bottom", c.X, bottom - 5, true},
+		{"no usb opening with connectors", "open", c.X, top + 3, true},
+	}
+	for _, ch := range checks {
+		if in_material(cad, ch.layer, ch.x, ch.y) != ch.material {
+			t.Errorf("TestConnectors: expected material %v at the %s on the %s layer [%.2f, %.2f]",
+				ch.material, ch.name, ch.layer, ch.x, ch.y)
+		}
+	}
+
+	// the openings follow the outline when it hugs the keys
+	json_str = `{
+		"layout":[
+			["","",""],
+			[{"x":1},"","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-edge":6,
+			"connectors":[{"edge":"left", "offset":9.525, "width":8}]
+		},
+		"outline":"layout",
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad = kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder = json.NewDecoder(strings.NewReader(json_str))
+	err = decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestConnectors: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "connectors_layout"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestConnectors: failed to Draw the KAD file")
+		return
+	}
+	key := cad.Layout[1][0]
+	edge := key.Bounds.Bounds().Xmin - 9
+	if in_material(cad, "open", edge+3, key.Center.Y) {
+		t.Errorf("TestConnectors: expected the opening through the edge beside the second row at x=%.2f", edge+3)
+	}
+	if !in_material(cad, "open", edge+3, key.Center.Y+6) {
+		t.Errorf("TestConnectors: expected the case edge beside the opening")
+	}
+}
+
+// Check if a point is in the material of a layer.
+func in_material(cad *kad.KAD, layer string, x, y float64) bool {
+	return kad.PointInPaths(kad.Point{X: x, Y: y}, cad.Layers[layer].KeepPolys)
+}
@@ -0,0 +1,76 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestKeyChecks(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["","",{"x":-1},""],
+			[{"y":-0.5,"x":0.25},"",""]
+		],
+		"custom":[{"layers":["switch"], "op":"cut", "polygon":"custom-rectangle",
+			"points":"[0,-19]", "rel_to":"[0,0]", "width":4, "height":10}],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-edge":6,
+			"connectors":[{"edge":"left", "width":8, "layers":["open","switch"]}]
+		},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestKeyChecks: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "key_checks"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestKeyChecks: failed to Draw the KAD file")
+		return
+	}
+
+	// the keys of the second row are moved up into the keys above them, which includes both of the stacked keys 1 and 2,
+	// the stacked keys are not reported against each other, the custom cut crosses the top edge
+	// and the connector is expected to cross the edge
+	codes := make(map[string]int)
+	overlaps := make([]string, 0)
+	for _, w := range cad.Result.Warnings {
+		codes[w.Code]++
+		if w.Code == kad.CHECK_KEY_OVERLAP {
+			overlaps = append(overlaps, fmt.Sprint(w.Keys))
+		}
+		if w.Code == kad.CHECK_OUT_OF_BOUNDS && w.Layer != kad.SWITCHLAYER {
+			t.Errorf("TestKeyChecks: unexpected cut out of bounds on the %s layer", w.Layer)
+		}
+	}
+	if strings.Join(overlaps, ",") != "[0 3],[1 4],[2 4]" {
+		t.Errorf("TestKeyChecks: expected keys 0 and 3, 1 and 4, 2 and 4 to overlap, got %v", overlaps)
+	}
+	if len(cad.Result.Warnings) != 4 || codes[kad.CHECK_OUT_OF_BOUNDS] != 1 {
+		t.Errorf("TestKeyChecks: expected three key overlaps and one cut out of bounds, got %v", codes)
+	}
+	if !cad.Layout[0][1].Stacked {
+		t.Errorf("TestKeyChecks: expected key 1 to be stacked")
+	}
+}
@@ -0,0 +1,133 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestCornerRelief(t *testing.T) {
+	cases := []struct {
+		relief  string
+		width   float64
+		height  float64
+		rounded bool
+	}{
+		{"dogbone", 14.93, 14.93, false},
+		{"t-bone", 14, 17.175, false},
+		{"fillet", 14, 14, true},
+		{"unknown", 14.93, 14.93, false}, // falls back to a dogbone
+	}
+	r := 3.175 / 2
+	for _, c := range cases {
+		json_str := `{
+			"switch-type":1,
+			"layout":[["",""]],
+			"fabrication":{"mode":"cnc", "tool-diameter":3.175, "relief":"` + c.relief + `"},
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9
+		}`
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestCornerRelief: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = "relief_" + strings.Replace(c.relief, "-", "", -1)
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestCornerRelief: failed to Draw the KAD file")
+			continue
+		}
+
+		// the switch cutouts are the two paths inside the outline
+		polys := cad.Layers[kad.SWITCHLAYER].KeepPolys
+		if len(polys) != 3 {
+			t.Errorf("TestCornerRelief: %s expected 3 paths in the switch layer, got %d", c.relief, len(polys))
+			continue
+		}
+		for _, poly := range polys[1:] {
+			b := poly.Bounds()
+			w, h := b.Xmax-b.Xmin, b.Ymax-b.Ymin
+			if math.Abs(w-c.width) > 0.01 || math.Abs(h-c.height) > 0.01 {
+				t.Errorf("TestCornerRelief: %s expected a %.3f x %.3f cutout, got %.3f x %.3f",
+					c.relief, c.width, c.height, w, h)
+			}
+			if kad.SurfaceArea([]kad.Path{poly}) >= 14*14 && c.relief == "fillet" {
+				t.Errorf("TestCornerRelief: expected the filleted cutout to be smaller than the switch")
+			}
+		}
+
+		// only the fillet relief rounds the outside corners of the outline to the tool radius
+		b := polys[0].Bounds()
+		missing := (b.Xmax-b.Xmin)*(b.Ymax-b.Ymin) - kad.SurfaceArea(polys[:1])
+		expected := 0.0
+		if c.rounded {
+			expected = (4 - math.Pi) * r * r
+		}
+		if math.Abs(missing-expected) > 0.05 {
+			t.Errorf("TestCornerRelief: %s expected the outline corners to be missing %.3f mm2, got %.3f",
+				c.relief, expected, missing)
+		}
+	}
+}
+
+func TestCornerReliefSlivers(t *testing.T) {
+	for _, relief := range []string{"dogbone", "t-bone"} {
+		json_str := `{
+			"switch-type":1,
+			"layout":[
+				["","","","","","",""],
+				["","","","","","",""]
+			],
+			"fabrication":{"mode":"cnc", "tool-diameter":2, "relief":"` + relief + `"},
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9
+		}`
+
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestCornerReliefSlivers: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = "relief_slivers_" + strings.Replace(relief, "-", "", -1)
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestCornerReliefSlivers: failed to Draw the KAD file")
+			continue
+		}
+
+		// the relief cuts past the corners, so no sliver of a corner is left which would still need relief
+		polys := cad.Layers[kad.SWITCHLAYER].KeepPolys
+		sign := kad.OuterSign(polys)
+		for _, path := range polys {
+			if centers := path.ReliefCenters(1, sign, false); len(centers) > 0 {
+				t.Errorf("TestCornerReliefSlivers: %s expected every corner to be relieved, found %v", relief, centers)
+			}
+		}
+	}
+}
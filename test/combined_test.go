@@ -0,0 +1,95 @@
+package kad
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestCombinedSvg(t *testing.T) {
+	for _, arrange := range []string{"side-by-side", "overlay"} {
+		json_str := `{
+			"switch-type":1,
+			"layout":[
+				["","",""],
+				["","",""]
+			],
+			"case": {"case-type":"sandwich"},
+			"combined-svg":{"arrange":"` + arrange + `", "spacing":10},
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9
+		}`
+
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestCombinedSvg: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = "combined_" + arrange
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestCombinedSvg: failed to Draw the KAD file")
+			continue
+		}
+
+		// each plate is a named inkscape layer with its bounding box, spaced out or on top of each other
+		data, err := os.ReadFile("./output/combined_" + arrange + "_combined.svg")
+		if err != nil {
+			t.Errorf("TestCombinedSvg: failed to read the %s combined svg", arrange)
+			continue
+		}
+		groups := make(map[string]string)
+		boxes := 0
+		decoder_xml := xml.NewDecoder(strings.NewReader(string(data)))
+		for {
+			token, err := decoder_xml.Token()
+			if err != nil {
+				if err != io.EOF {
+					t.Errorf("TestCombinedSvg: invalid %s svg, %s", arrange, err.Error())
+				}
+				break
+			}
+			if el, ok := token.(xml.StartElement); ok && el.Name.Local == "g" {
+				id, transform := "", ""
+				for _, a := range el.Attr {
+					switch a.Name.Local {
+					case "id":
+						id = a.Value
+					case "transform":
+						transform = a.Value
+					}
+				}
+				groups[id] = transform
+			} else if ok && el.Name.Local == "rect" {
+				boxes++
+			}
+		}
+		if len(groups) != len(cad.Result.Plates) || boxes != len(cad.Result.Plates) {
+			t.Errorf("TestCombinedSvg: expected a group and a box for each of the %d plates, got %d and %d", len(cad.Result.Plates), len(groups), boxes)
+		}
+		expected := "translate(0.000,0)"
+		if arrange == "side-by-side" { // the second plate starts after the first plate and the spacing
+			expected = fmt.Sprintf("translate(%.3f,0)", cad.Layers[cad.Result.Plates[0]].Width+2*cad.DMZ+10)
+		}
+		if groups[cad.Result.Plates[1]] != expected {
+			t.Errorf("TestCombinedSvg: expected the %s plate at %s, got %s", arrange, expected, groups[cad.Result.Plates[1]])
+		}
+	}
+}
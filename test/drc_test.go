@@ -0,0 +1,56 @@
+package kad
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestDesignRules(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[["",""]],
+		"custom":[{"layers":["switch"], "op":"cut", "polygon":"custom-circle",
+			"points":"[9.525,-8.5]", "rel_to":"[0,0]", "diameter":2}],
+		"design-rules":{"min-web":2, "min-feature":2.5, "overlay":true},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestDesignRules: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "design_rules"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestDesignRules: failed to Draw the KAD file")
+		return
+	}
+
+	// the small hole is too close to the switch cutout and is too small
+	codes := make(map[string]int)
+	for _, w := range cad.Result.Warnings {
+		if w.Layer != kad.SWITCHLAYER {
+			t.Errorf("TestDesignRules: unexpected violation on the %s layer: %s", w.Layer, w.Message)
+		}
+		codes[w.Code]++
+	}
+	if codes[kad.DRC_MIN_WEB] != 1 || codes[kad.DRC_MIN_FEATURE] != 1 {
+		t.Errorf("TestDesignRules: expected one web and one feature violation, got %v", codes)
+	}
+}
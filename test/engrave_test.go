@@ -0,0 +1,149 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestEngraving(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["Esc","!\n1",{"w":1.5},"Tab"],
+			["<b>Q</b>","W","E"]
+		],
+		"case": {"case-type":"sandwich"},
+		"engraving":{"legends":["switch","bottom"], "layer-text":true, "serial":"SN-1", "alignment-marks":true},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestEngraving: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "engraving"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestEngraving: failed to Draw the KAD file")
+		return
+	}
+
+	// the legends go on the switch and bottom plates, and every plate gets the layer text
+	legends := []string{"Esc", "! 1", "Tab", "Q", "W", "E"}
+	for _, layer := range []string{kad.SWITCHLAYER, kad.BOTTOMLAYER} {
+		texts := make([]string, 0)
+		for _, l := range cad.Layers[layer].Labels {
+			texts = append(texts, l.Text)
+		}
+		for _, legend := range legends {
+			if !in_strings(legend, texts) {
+				t.Errorf("TestEngraving: expected the %q legend on the %s layer, got %v", legend, layer, texts)
+			}
+		}
+	}
+	for _, layer := range cad.Result.Plates {
+		found := false
+		for _, l := range cad.Layers[layer].Labels {
+			found = found || l.Text == cad.Result.Details[layer].Name+" SN-1"
+		}
+		if !found {
+			t.Errorf("TestEngraving: expected the layer text on the %s layer", layer)
+		}
+	}
+
+	// the engraving is drawn in its own group, in a different color to the cuts
+	data, err := os.ReadFile("./output/engraving_switch.svg")
+	if err != nil {
+		t.Errorf("TestEngraving: failed to read the switch layer svg")
+		return
+	}
+	svg := string(data)
+	if !strings.Contains(svg, `<g id="cut"`) || !strings.Contains(svg, `<g id="engrave"`) {
+		t.Errorf("TestEngraving: expected separate cut and engrave groups")
+	}
+	if !strings.Contains(svg, "stroke:red") || !strings.Contains(svg, "fill:blue") || strings.Count(svg, "<line") != 8 {
+		t.Errorf("TestEngraving: expected red cuts, blue text and 4 alignment marks")
+	}
+}
+
+func TestEngravingRotated(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			[{"r":15,"rx":1,"ry":1},"A"]
+		],
+		"case": {"case-type":"sandwich"},
+		"engraving":{"legends":["switch"]},
+		"line-color":"green",
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestEngravingRotated: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "engraving_rotated"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestEngravingRotated: failed to Draw the KAD file")
+		return
+	}
+
+	// the legend turns with the key and sits straight above the switch opening in the frame of the key
+	labels := cad.Layers[kad.SWITCHLAYER].Labels
+	if len(labels) != 1 || labels[0].Rotate != 15 {
+		t.Errorf("TestEngravingRotated: expected the legend rotated by 15 degrees, got %+v", labels)
+		return
+	}
+	key := cad.Layout[0][0]
+	d := kad.Path{{X: labels[0].At.X - key.Center.X, Y: labels[0].At.Y - key.Center.Y}}
+	d.RotatePath(-key.Angle, kad.Point{})
+	if math.Abs(d[0].X) > 1e-6 || d[0].Y > -7 || d[0].Y < -9.525 {
+		t.Errorf("TestEngravingRotated: expected the legend above the opening of the key, got an offset of %v", d[0])
+	}
+
+	// the line color which was asked for is kept for the cuts
+	data, err := os.ReadFile("./output/engraving_rotated_switch.svg")
+	if err != nil {
+		t.Errorf("TestEngravingRotated: failed to read the switch layer svg")
+		return
+	}
+	svg := string(data)
+	if !strings.Contains(svg, "stroke:green") || strings.Contains(svg, "stroke:red") {
+		t.Errorf("TestEngravingRotated: expected the cuts to keep the green line color")
+	}
+	if !strings.Contains(svg, "rotate(15.000 ") {
+		t.Errorf("TestEngravingRotated: expected the legend to be rotated in the svg")
+	}
+}
@@ -0,0 +1,71 @@
+package kad
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestFlexCuts(t *testing.T) {
+	for _, pattern := range []string{"rows", "columns", "spine-free"} {
+		json_str := `{
+			"switch-type":1,
+			"layout":[
+				["","","",""],
+				["","","",""],
+				["","","",""]
+			],
+			"case": {"case-type":"sandwich"},
+			"flex-cuts":{"pattern":"` + pattern + `", "slot-width":1.5, "clearance":1},
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9
+		}`
+
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestFlexCuts: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = "flex_" + pattern
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestFlexCuts: failed to Draw the KAD file")
+			continue
+		}
+
+		// the slots run between the rows or columns, clear of the 12 switch openings
+		slots, openings := 0, 0
+		for _, path := range cad.Layers[kad.SWITCHLAYER].KeepPolys {
+			b := path.Bounds()
+			w, h := b.Xmax-b.Xmin, b.Ymax-b.Ymin
+			if (w > 1.49 && w < 1.51 && h > 10) || (h > 1.49 && h < 1.51 && w > 10) {
+				slots++
+			} else if w > 13.9 && w < 14.1 && h > 13.9 && h < 14.1 {
+				openings++
+			}
+		}
+		expected := map[string]int{"rows": 2, "columns": 3, "spine-free": 2}[pattern]
+		if slots != expected {
+			t.Errorf("TestFlexCuts: expected %d %s slots, got %d", expected, pattern, slots)
+		}
+		if openings != 12 {
+			t.Errorf("TestFlexCuts: expected the %s slots to leave 12 switch openings, got %d", pattern, openings)
+		}
+		if len(cad.Result.Warnings) != 0 {
+			t.Errorf("TestFlexCuts: unexpected %s warnings %v", pattern, cad.Result.Warnings)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestGcode(t *testing.T) {
+	cases := []struct {
+		mode string
+		kerf float64
+	}{
+		{"cnc", 0},
+		{"cnc", 0.5}, // the kerf does not change the tool path
+		{"laser", 0},
+	}
+	for _, c := range cases {
+		mode := c.mode
+		json_str := `{
+			"switch-type":1,
+			"layout":[
+				["","",""],
+				["","",""]
+			],
+			"case": {"case-type":"sandwich"},
+			"fabrication":{"mode":"` + mode + `", "tool-diameter":2},
+			"gcode":{"depth":3, "depth-per-pass":1, "tabs":4, "tab-width":4, "tab-height":1},
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9,
+			"kerf":` + fmt.Sprint(c.kerf) + `
+		}`
+
+		hash := "gcode_" + mode
+		if c.kerf > 0 {
+			hash += "_kerf"
+		}
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg", "gcode"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestGcode: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = hash
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestGcode: failed to Draw the KAD file")
+			continue
+		}
+
+		// the 6 switch openings are cut before the outline
+		contours := cad.GcodeContours(kad.SWITCHLAYER)
+		if len(contours) != 7 {
+			t.Errorf("TestGcode: expected 7 %s contours, got %d", mode, len(contours))
+			continue
+		}
+		last := contours[len(contours)-1].Bounds()
+		for _, c := range contours[:len(contours)-1] {
+			if b := c.Bounds(); b.Xmax-b.Xmin >= last.Xmax-last.Xmin {
+				t.Errorf("TestGcode: expected the %s outline to be cut last", mode)
+			}
+		}
+
+		data, err := os.ReadFile("./output/" + hash + "_switch.gcode")
+		if err != nil {
+			t.Errorf("TestGcode: failed to read the %s switch layer g-code", mode)
+			continue
+		}
+		gcode := string(data)
+		if mode == "cnc" {
+			// the tool path is offset by the tool radius, each contour gets 3 passes and the outline has 4 tabs
+			b := cad.Layers[kad.SWITCHLAYER].KeepPolys[0].Bounds()
+			if w := b.Xmax - b.Xmin - c.kerf + 2; math.Abs(last.Xmax-last.Xmin-w) > 0.01 {
+				t.Errorf("TestGcode: expected the outline to be offset by the tool radius with a %.1f kerf", c.kerf)
+			}
+			if n := strings.Count(gcode, "G1 Z-3.000 F200\n"); n != 7+4 {
+				t.Errorf("TestGcode: expected 7 final passes and 4 tab drops, got %d", n)
+			}
+			if n := strings.Count(gcode, "G1 Z-2.000 F200\n"); n != 7+4 {
+				t.Errorf("TestGcode: expected 7 second passes and 4 tab climbs, got %d", n)
+			}
+		} else {
+			if strings.Contains(gcode, " Z") || strings.Count(gcode, "F600 S0\n") != 3*4 {
+				t.Errorf("TestGcode: expected laser g-code with the laser off over 4 tabs on 3 passes")
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestGeoJson(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["Esc","Q","W"],
+			["A","S","D"]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6
+		},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "geojson"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestGeoJson: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "geojson"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestGeoJson: failed to Draw the KAD file")
+		return
+	}
+
+	data, err := os.ReadFile("./output/geojson_switch.geojson")
+	if err != nil {
+		t.Errorf("TestGeoJson: failed to read the switch layer geojson")
+		return
+	}
+	var geo struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	if err = json.Unmarshal(data, &geo); err != nil || geo.Type != "FeatureCollection" {
+		t.Errorf("TestGeoJson: expected a feature collection")
+		return
+	}
+
+	// one contour with the 6 switch openings and 4 mount holes, then the key centers and mount holes
+	kinds := make(map[string]int)
+	for _, f := range geo.Features {
+		kinds[f.Properties["kind"].(string)]++
+		if f.Geometry.Type != "Polygon" {
+			continue
+		}
+		var rings [][][2]float64
+		json.Unmarshal(f.Geometry.Coordinates, &rings)
+		if len(rings) != 11 {
+			t.Errorf("TestGeoJson: expected the contour to have 10 holes, got %d", len(rings)-1)
+		}
+		for i, ring := range rings {
+			area := 0.0
+			for j := 0; j+1 < len(ring); j++ {
+				area += ring[j][0]*ring[j+1][1] - ring[j+1][0]*ring[j][1]
+			}
+			if ring[0] != ring[len(ring)-1] || (i == 0) != (area > 0) {
+				t.Errorf("TestGeoJson: expected closed rings, counter clockwise outside and clockwise holes")
+				break
+			}
+		}
+	}
+	if kinds["contour"] != 1 || kinds["key"] != 6 || kinds["mount-hole"] != 4 {
+		t.Errorf("TestGeoJson: expected 1 contour, 6 keys and 4 mount holes, got %v", kinds)
+	}
+	for _, f := range geo.Features {
+		if f.Properties["kind"] == "key" && f.Properties["label"] == "Esc" {
+			var c [2]float64
+			json.Unmarshal(f.Geometry.Coordinates, &c)
+			if c[0] != 23.525 || math.Abs(c[1]-(cad.Layers[kad.SWITCHLAYER].Height+2*cad.DMZ-23.525)) > 1e-4 {
+				t.Errorf("TestGeoJson: expected the Esc key at [23.525, %.4f], got %v", cad.Layers[kad.SWITCHLAYER].Height+2*cad.DMZ-23.525, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package kad
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestHolePlacement(t *testing.T) {
+	layout := `"layout":[
+			[{"a":7},"","","","","","",""],
+			[{"w":1.5},"","","","","",{"w":1.5},""],
+			[{"w":1.75},"","","","",{"w":2.25},""],
+			[{"w":2.25},"","","",{"w":2.75},""]
+		],`
+	sandwich := `"case-type":"sandwich","mount-holes-size":3,"mount-holes-edge":6,`
+	connector := `"connectors":[{"edge":"top","width":60}]`
+	cases := []struct {
+		hash     string
+		settings string
+		holes    int
+		warnings []string // layer of each warning
+	}{
+		{"holes_tray", `"case":{"mount-holes-placement":"tray","mount-holes-num":5,"mount-holes-size":2.5}`, 5, nil},
+		{"holes_edge", `"case":{` + sandwich + `"mount-holes-placement":"edge","mount-holes-num":5}`, 5, nil},
+		{"holes_points", `"case":{"mount-holes-points":"[-x+18.5,-y+18.5];[-x+4,-y+4];[x-4,y-4]","mount-holes-size":3}`,
+			3, []string{"switch"}},
+		{"holes_unknown", `"case":{` + sandwich + `"mount-holes-placement":"spiral","mount-holes-num":4}`, 4, nil},
+		{"holes_edge_connector", `"case":{` + sandwich + `"mount-holes-placement":"edge","mount-holes-num":6,` + connector + `}`,
+			6, nil},
+		{"holes_points_connector", `"case":{` + sandwich + `"mount-holes-points":"[0,-y+4]",` + connector + `}`,
+			1, []string{"open"}},
+	}
+	for _, c := range cases {
+		json_str := `{` + layout + c.settings + `,
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9,
+			"fillet":3
+		}`
+
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestHolePlacement: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = c.hash
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestHolePlacement: failed to Draw the KAD file")
+			continue
+		}
+		if len(cad.MountHoles) != c.holes {
+			t.Errorf("TestHolePlacement: %s placed %d holes, expected %d", c.hash, len(cad.MountHoles), c.holes)
+		}
+		layers := make([]string, 0)
+		for _, w := range cad.Result.Warnings {
+			if w.Code == kad.HOLE_CONFLICT {
+				layers = append(layers, w.Layer)
+			}
+		}
+		if strings.Join(layers, ",") != strings.Join(c.warnings, ",") {
+			t.Errorf("TestHolePlacement: %s reported conflicts on %v, expected %v", c.hash, layers, c.warnings)
+		}
+		// the holes without conflicts are surrounded by the case edge, so they keep clear of the connector
+		if !in_strings(kad.OPENLAYER, cad.Result.Plates) || len(c.warnings) > 0 {
+			continue
+		}
+		for _, h := range cad.MountHoles {
+			r := cad.Case.HoleDiameter/2 + 0.5
+			for _, d := range []kad.Point{{X: r}, {X: -r}, {Y: r}, {Y: -r}} {
+				if !in_material(cad, kad.OPENLAYER, h.X+d.X, h.Y+d.Y) {
+					t.Errorf("TestHolePlacement: %s placed a hole at %v which is not in the case edge", c.hash, h)
+					break
+				}
+			}
+		}
+	}
+}
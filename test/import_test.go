@@ -0,0 +1,124 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestImportOutline(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["Esc","Q","W"],
+			["A","S","D"]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-edge":6
+		},
+		"outline":"import",
+		"outline-import": {
+			"svg":"<svg xmlns='http://www.w3.org/2000/svg'><path d='M0,0 h200 a20,20 0 0 1 20,20 v100 h-220 z'/></svg>",
+			"scale":0.5
+		},
+		"custom": [{
+			"layers":["bottom"],
+			"op":"cut",
+			"polygon":"custom-import",
+			"import": {
+				"dxf":"0\nSECTION\n2\nENTITIES\n0\nLWPOLYLINE\n8\n0\n90\n4\n70\n1\n10\n0\n20\n0\n10\n10\n20\n0\n42\n1\n10\n10\n20\n10\n10\n0\n20\n10\n0\nENDSEC\n0\nEOF\n"
+			}
+		}],
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestImportOutline: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "import_outline"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestImportOutline: failed to Draw the KAD file")
+		return
+	}
+
+	// the svg is scaled to 110 x 60 with a 10mm radius on the top right corner
+	d := cad.Result.Details[kad.BOTTOMLAYER]
+	if math.Abs(d.Width-110) > 0.01 || math.Abs(d.Height-60) > 0.01 {
+		t.Errorf("TestImportOutline: expected a 110 x 60 case, got %.3f x %.3f", d.Width, d.Height)
+	}
+
+	// the dxf is a 10mm square with a half circle on one side, centered on the layout
+	polys := cad.Layers[kad.BOTTOMLAYER].KeepPolys
+	if len(polys) != 2 {
+		t.Errorf("TestImportOutline: expected the bottom layer to have one cut, got %d paths", len(polys)-1)
+		return
+	}
+	outline_area := 110*60 - (100 - math.Pi*100/4)
+	cut_area := 100 + math.Pi*25/2
+	if math.Abs(d.Area-(outline_area-cut_area)) > 0.5 {
+		t.Errorf("TestImportOutline: expected an area of %.2f, got %.2f", outline_area-cut_area, d.Area)
+	}
+	outer, cut := polys[0].Bounds(), polys[1].Bounds()
+	if math.Abs(polys[0].SignedArea()) < math.Abs(polys[1].SignedArea()) {
+		outer, cut = cut, outer
+	}
+	if cut.Xmax-cut.Xmin < 14.99 || cut.Xmax-cut.Xmin > 15.01 ||
+		math.Abs((cut.Xmin+cut.Xmax)-(outer.Xmin+outer.Xmax)) > 0.01 ||
+		math.Abs((cut.Ymin+cut.Ymax)-(outer.Ymin+outer.Ymax)) > 0.01 {
+		t.Errorf("TestImportOutline: expected a 15mm wide cut in the middle of the case, got %+v in %+v", cut, outer)
+	}
+
+	// the case edge follows the imported outline
+	if n := len(cad.Layers[kad.CLOSEDLAYER].KeepPolys); n != 2 {
+		t.Errorf("TestImportOutline: expected the closed layer to be a frame, got %d paths", n)
+	}
+}
+
+func TestParseSvgOutline(t *testing.T) {
+	cases := []struct {
+		name string
+		svg  string
+		want kad.Bounds
+	}{
+		{"transforms in mm", `<svg width="100mm" height="50mm" viewBox="0 0 200 100">
+			<defs><rect width="500" height="500"/></defs>
+			<clipPath id="clip"><circle r="400"/></clipPath>
+			<g transform="translate(10,10)"><rect width="20" height="10" transform="rotate(90)"/></g>
+		</svg>`, kad.Bounds{Xmin: 0, Ymin: 5, Xmax: 5, Ymax: 15}},
+		{"user units", `<svg width="300" height="300"><path transform="scale(2)" d="M1,1 h10 v10 h-10 z"/></svg>`,
+			kad.Bounds{Xmin: 2, Ymin: 2, Xmax: 22, Ymax: 22}},
+		{"inches without a viewBox", `<svg width="2in" height="2in"><rect x="96" width="96" height="48"/></svg>`,
+			kad.Bounds{Xmin: 25.4, Ymin: 0, Xmax: 50.8, Ymax: 12.7}},
+	}
+	for _, c := range cases {
+		paths := kad.ParseSvgOutline(c.svg)
+		if len(paths) != 1 {
+			t.Errorf("TestParseSvgOutline: %s expected 1 path, got %d", c.name, len(paths))
+			continue
+		}
+		b := paths[0].Bounds()
+		if math.Abs(b.Xmin-c.want.Xmin) > 0.001 || math.Abs(b.Ymin-c.want.Ymin) > 0.001 ||
+			math.Abs(b.Xmax-c.want.Xmax) > 0.001 || math.Abs(b.Ymax-c.want.Ymax) > 0.001 {
+			t.Errorf("TestParseSvgOutline: %s expected %+v, got %+v", c.name, c.want, b)
+		}
+	}
+}
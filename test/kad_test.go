@@ -145,3 +145,12 @@ func TestClipperCleanPolygon(t *testing.T) {
 		}
 	}
 }
+
+func in_strings(query string, strs []string) bool {
+	for _, s := range strs {
+		if s == query {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestKicad(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"stab-type":1,
+		"layout":[
+			["Esc","Q",{"w":2},"Bksp"],
+			[{"_r":15},"A","S"]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6
+		},
+		"kicad": {"enabled":true},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestKicad: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "kicad"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestKicad: failed to Draw the KAD file")
+		return
+	}
+
+	// 5 switches, a stabilizer for the 2u key and the 4 mount holes
+	footprints := cad.KicadFootprints()
+	if len(footprints) != 5+1+4 {
+		t.Errorf("TestKicad: expected 10 footprints, got %d", len(footprints))
+		return
+	}
+	if f := footprints[3]; f.Ref != "SW4" || f.Value != "A" || f.Rotate != -15 || f.At.X != 23.525 || f.At.Y != 42.575 {
+		t.Errorf("TestKicad: expected SW4 to be the rotated A key at [23.525, 42.575], got %+v", f)
+	}
+	if f := footprints[5]; f.Ref != "ST1" || f.Name != "Button_Switch_Keyboard:Stabilizer_Cherry_MX_2.00u" || f.At != footprints[2].At {
+		t.Errorf("TestKicad: expected a 2u stabilizer under the Bksp key, got %+v", f)
+	}
+
+	data, err := os.ReadFile("./output/kicad_pcb.kicad_pcb")
+	if err != nil {
+		t.Errorf("TestKicad: failed to read the kicad board")
+		return
+	}
+	pcb := string(data)
+	if n := strings.Count(pcb, "(layer \"Edge.Cuts\")"); n != 4 {
+		t.Errorf("TestKicad: expected the case interior as 4 Edge.Cuts lines, got %d", n)
+	}
+	if n := strings.Count(pcb, "(footprint "); n != 10 {
+		t.Errorf("TestKicad: expected 10 footprints on the board, got %d", n)
+	}
+	if !strings.Contains(pcb, "(at 23.5250 42.5750 -15)") {
+		t.Errorf("TestKicad: expected the rotated switch on the board")
+	}
+	if len(cad.Result.Pcb) != 2 {
+		t.Errorf("TestKicad: expected the board and the position file, got %v", cad.Result.Pcb)
+	}
+	// the board edge is the opening which was cut out of the closed layer
+	if len(cad.Interior) != 1 || len(cad.Interior[0]) != 4 {
+		t.Errorf("TestKicad: expected the case interior as 4 corners, got %v", cad.Interior)
+		return
+	}
+	for _, p := range cad.Interior[0] {
+		if !strings.Contains(pcb, fmt.Sprintf("(start %.4f %.4f)", p.X, p.Y)) {
+			t.Errorf("TestKicad: expected an Edge.Cuts line from %v", p)
+		}
+		found := false
+		for _, poly := range cad.Layers["closed"].KeepPolys {
+			for _, q := range poly {
+				if math.Abs(q.X-p.X) < 1e-3 && math.Abs(q.Y-p.Y) < 1e-3 { // the cuts are rounded to the clipper precision
+					found = true
+				}
+			}
+		}
+		if !found {
+			t.Errorf("TestKicad: expected the board corner %v on the opening of the closed layer", p)
+		}
+	}
+	for _, layer := range cad.Result.Plates {
+		if len(cad.Result.Details[layer].Exports) != 1 {
+			t.Errorf("TestKicad: expected only the svg of the %s layer, got %v", layer, cad.Result.Details[layer].Exports)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package kad
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestMetrics(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[["",""]],
+		"material":{"name":"aluminium", "thickness":1.5, "density":2.7, "price-per-area":100, "price-per-cut":1},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestMetrics: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "metrics"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestMetrics: failed to Draw the KAD file")
+		return
+	}
+
+	// a 56.1 x 37.05 plate with two 14 x 14 switch cutouts
+	near := func(a, b float64) bool { return a-b < 0.01 && b-a < 0.01 }
+	d := cad.Result.Details[kad.SWITCHLAYER]
+	if !near(d.CutLength, 298.3) || d.Pierces != 3 {
+		t.Errorf("TestMetrics: expected 298.3mm of cut with 3 pierces, got %.3fmm with %d pierces", d.CutLength, d.Pierces)
+	}
+	if !near(d.SheetWidth, 56.1) || !near(d.SheetHeight, 37.05) {
+		t.Errorf("TestMetrics: expected a 56.1 x 37.05 sheet, got %.3f x %.3f", d.SheetWidth, d.SheetHeight)
+	}
+	if !near(d.Weight, 6.830) || !near(d.Cost, 0.506) {
+		t.Errorf("TestMetrics: expected a weight of 6.830g and cost of 0.506, got %.3fg and %.3f", d.Weight, d.Cost)
+	}
+	if !near(cad.Result.Weight, d.Weight) || !near(cad.Result.Cost, d.Cost) {
+		t.Errorf("TestMetrics: expected the totals to match the only plate")
+	}
+}
@@ -0,0 +1,105 @@
+package kad
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestModels(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"stab-type":1,
+		"layout":[
+			["","","",""],
+			[{"w":2},"","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6
+		},
+		"model":{"thickness":{"switch":1.5}},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "stl", "3mf"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestModels: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "models"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestModels: failed to Draw the KAD file")
+		return
+	}
+
+	// the extruded switch layer is closed, so every edge is shared with a triangle going the other way
+	mesh := cad.LayerMesh(kad.SWITCHLAYER, 0)
+	edges := make(map[[2]kad.Point3]int)
+	for _, tri := range mesh {
+		for i := range tri {
+			edges[[2]kad.Point3{tri[i], tri[(i+1)%3]}]++
+		}
+	}
+	for e, n := range edges {
+		if edges[[2]kad.Point3{e[1], e[0]}] != n {
+			t.Errorf("TestModels: expected a closed mesh, the edge %v is not matched", e)
+			break
+		}
+	}
+
+	// the top of the layer covers the same area as the plate
+	area, top := 0.0, 0.0
+	for _, path := range kad.OrientPaths(cad.Layers[kad.SWITCHLAYER].KeepPolys) {
+		area += path.SignedArea()
+	}
+	for _, tri := range mesh {
+		if tri[0].Z == 1.5 && tri[1].Z == 1.5 && tri[2].Z == 1.5 {
+			top += (kad.Path{{X: tri[0].X, Y: tri[0].Y}, {X: tri[1].X, Y: tri[1].Y}, {X: tri[2].X, Y: tri[2].Y}}).SignedArea()
+		}
+	}
+	if math.Abs(math.Abs(area)-top) > 0.01 {
+		t.Errorf("TestModels: expected the top of the switch layer to have an area of %.2f, got %.2f", math.Abs(area), top)
+	}
+
+	// the stacked case is 4 layers at 3mm and the switch layer at 1.5mm
+	stl, err := os.ReadFile("./output/models_case.stl")
+	if err != nil {
+		t.Errorf("TestModels: failed to read the case stl")
+		return
+	}
+	if n := int(binary.LittleEndian.Uint32(stl[80:84])); len(stl) != 84+50*n {
+		t.Errorf("TestModels: expected %d triangles in the stl", n)
+	}
+	if zmax := math.Float32frombits(binary.LittleEndian.Uint32(stl[len(stl)-6:])); zmax > 13.5+1e-3 {
+		t.Errorf("TestModels: expected the case to be 13.5mm tall, got %.3f", zmax)
+	}
+	if len(cad.Result.Models) != 2 {
+		t.Errorf("TestModels: expected stl and 3mf case models, got %v", cad.Result.Models)
+	}
+	if _, err := zip.OpenReader("./output/models_case.3mf"); err != nil {
+		t.Errorf("TestModels: expected the 3mf case model to be a zip archive")
+	}
+}
@@ -0,0 +1,148 @@
+package kad
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestNesting(t *testing.T) {
+	json_str := `{
+		"layout":[
+			["","","",""],
+			["","","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6
+		},
+		"nesting":{"sheet-width":200, "sheet-height":200, "spacing":5, "rotate":true, "copies":{"switch":2}},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "dxf", "geojson"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestNesting: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "nesting"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestNesting: failed to Draw the KAD file")
+		return
+	}
+
+	// six 94.2 x 56.1 plates, three shelves of one plate fit on each sheet
+	if len(cad.Result.Sheets) != 2 {
+		t.Errorf("TestNesting: expected 2 sheets, got %d", len(cad.Result.Sheets))
+		return
+	}
+	parts := 0
+	for _, s := range cad.Result.Sheets {
+		d := cad.Result.Details[s]
+		parts += len(d.Parts)
+		if strings.Contains(strings.Join(cad.Result.Plates, ","), s) {
+			t.Errorf("TestNesting: expected %s to be kept out of the plates", s)
+		}
+		if len(d.Exports) != 2 || d.Exports[0].Ext != "svg" || d.Exports[1].Ext != "dxf" {
+			t.Errorf("TestNesting: expected only the svg and dxf of %s, got %v", s, d.Exports)
+		}
+		if _, err := os.Stat("./output/nesting_" + s + ".dxf"); err != nil {
+			t.Errorf("TestNesting: expected the dxf of %s to be written", s)
+		}
+		if _, err := os.Stat("./output/nesting_" + s + ".geojson"); err == nil {
+			t.Errorf("TestNesting: expected no geojson for %s", s)
+		}
+		// the filleted corners are placed on the sheet with the plates
+		if arcs := cad.LayerArcs(s); arcs == &cad.Arcs || len(arcs.Arcs) < 4*len(d.Parts) {
+			t.Errorf("TestNesting: expected the arcs of the plates on %s, got %d", s, len(arcs.Arcs))
+		}
+		if d.Utilisation <= 0 || d.Utilisation > 100 {
+			t.Errorf("TestNesting: unexpected utilisation of %.1f%% for %s", d.Utilisation, s)
+		}
+		// the plates have to stay on the sheet without overlapping
+		for i, a := range d.Parts {
+			pa := kad.Path{}
+			for _, poly := range cad.Layers[s].KeepPolys {
+				pa = append(pa, poly...)
+			}
+			b := pa.Bounds()
+			if b.Xmin < cad.DMZ || b.Ymin < cad.DMZ || b.Xmax > cad.DMZ+200 || b.Ymax > cad.DMZ+200 {
+				t.Errorf("TestNesting: plates are drawn outside of %s", s)
+			}
+			for _, c := range d.Parts[i+1:] {
+				if a.X == c.X && a.Y == c.Y {
+					t.Errorf("TestNesting: %s and %s are placed at the same position on %s", a.Layer, c.Layer, s)
+				}
+			}
+		}
+	}
+	if parts != 6 {
+		t.Errorf("TestNesting: expected 6 plates to be nested, got %d", parts)
+	}
+}
+
+func TestNestingTooLarge(t *testing.T) {
+	json_str := `{
+		"layout":[
+			["","","",""],
+			["","","",""]
+		],
+		"nesting":{"sheet-width":80, "sheet-height":80, "spacing":5},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestNestingTooLarge: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "nesting_too_large"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestNestingTooLarge: failed to Draw the KAD file")
+		return
+	}
+
+	// the 94.2mm wide plates do not fit on an 80mm sheet, so each of them is reported and no sheet is drawn
+	layers := make([]string, 0)
+	for _, w := range cad.Result.Warnings {
+		if w.Code == kad.NEST_TOO_LARGE {
+			layers = append(layers, w.Layer)
+		}
+	}
+	if len(layers) != len(cad.Result.Plates) || len(cad.Result.Sheets) != 0 {
+		t.Errorf("TestNestingTooLarge: expected each of %v to be too large, got %v and %d sheets",
+			cad.Result.Plates, layers, len(cad.Result.Sheets))
+	}
+}
@@ -0,0 +1,154 @@
+(gcode_cnc bottom)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X80.350 Y62.081
+G1 Z-1.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 X81.151 Y26.872 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 X36.397 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 X4.000 Y39.229 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 X48.754 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 Z-2.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 X81.151 Y26.872 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 X36.397 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 X4.000 Y39.229 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 X48.754 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 Z-3.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 Z-2.000 F200
+G1 X81.151 Y26.872 F600
+G1 Z-3.000 F200
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 Z-2.000 F200
+G1 X36.397 Y4.000 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 Z-2.000 F200
+G1 X4.000 Y39.229 F600
+G1 Z-3.000 F200
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 Z-2.000 F200
+G1 X48.754 Y62.101 F600
+G1 Z-3.000 F200
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
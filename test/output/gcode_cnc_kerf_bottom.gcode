@@ -0,0 +1,142 @@
+(gcode_cnc_kerf bottom)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X80.823 Y62.331
+G1 Z-1.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-2.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-3.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 Z-2.000 F200
+G1 X81.401 Y26.953 F600
+G1 Z-3.000 F200
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 Z-2.000 F200
+G1 X36.478 Y4.250 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 Z-2.000 F200
+G1 X4.250 Y39.648 F600
+G1 Z-3.000 F200
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 Z-2.000 F200
+G1 X49.173 Y62.351 F600
+G1 Z-3.000 F200
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
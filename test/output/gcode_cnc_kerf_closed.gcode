@@ -0,0 +1,516 @@
+(gcode_cnc_kerf closed)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X15.204 Y51.646
+G1 Z-1.000 F200
+G1 X15.160 Y51.642 F600
+G1 X15.148 Y51.640 F600
+G1 X15.127 Y51.634 F600
+G1 X15.094 Y51.620 F600
+G1 X15.079 Y51.612 F600
+G1 X15.048 Y51.592 F600
+G1 X15.028 Y51.573 F600
+G1 X15.009 Y51.553 F600
+G1 X14.982 Y51.510 F600
+G1 X14.967 Y51.474 F600
+G1 X14.960 Y51.447 F600
+G1 X14.955 Y51.404 F600
+G1 X14.956 Y51.380 F600
+G1 X14.959 Y51.352 F600
+G1 X14.964 Y51.328 F600
+G1 X14.985 Y51.277 F600
+G1 X15.016 Y51.232 F600
+G1 X15.052 Y51.193 F600
+G1 X15.154 Y51.053 F600
+G1 X15.221 Y50.894 F600
+G1 X15.250 Y50.686 F600
+G1 X15.250 Y15.916 F600
+G1 X15.230 Y15.744 F600
+G1 X15.171 Y15.582 F600
+G1 X15.052 Y15.409 F600
+G1 X15.012 Y15.366 F600
+G1 X14.987 Y15.328 F600
+G1 X14.972 Y15.296 F600
+G1 X14.967 Y15.283 F600
+G1 X14.960 Y15.256 F600
+G1 X14.955 Y15.213 F600
+G1 X14.956 Y15.189 F600
+G1 X14.960 Y15.155 F600
+G1 X14.965 Y15.135 F600
+G1 X14.980 Y15.096 F600
+G1 X14.989 Y15.080 F600
+G1 X15.009 Y15.049 F600
+G1 X15.024 Y15.033 F600
+G1 X15.048 Y15.010 F600
+G1 X15.079 Y14.990 F600
+G1 X15.104 Y14.977 F600
+G1 X15.127 Y14.968 F600
+G1 X15.154 Y14.961 F600
+G1 X15.204 Y14.956 F600
+G1 X15.255 Y14.961 F600
+G1 X15.282 Y14.968 F600
+G1 X15.324 Y14.986 F600
+G1 X15.361 Y15.011 F600
+G1 X15.407 Y15.053 F600
+G1 X15.547 Y15.154 F600
+G1 X15.707 Y15.221 F600
+G1 X15.914 Y15.250 F600
+G1 X69.736 Y15.250 F600
+G1 X69.908 Y15.230 F600
+G1 X70.070 Y15.171 F600
+G1 X70.243 Y15.053 F600
+G1 X70.289 Y15.011 F600
+G1 X70.326 Y14.986 F600
+G1 X70.368 Y14.968 F600
+G1 X70.395 Y14.961 F600
+G1 X70.446 Y14.956 F600
+G1 X70.496 Y14.961 F600
+G1 X70.523 Y14.968 F600
+G1 X70.556 Y14.982 F600
+G1 X70.571 Y14.990 F600
+G1 X70.602 Y15.010 F600
+G1 X70.626 Y15.033 F600
+G1 X70.641 Y15.049 F600
+G1 X70.661 Y15.080 F600
+G1 X70.670 Y15.096 F600
+G1 X70.685 Y15.135 F600
+G1 X70.690 Y15.155 F600
+G1 X70.694 Y15.189 F600
+G1 X70.695 Y15.213 F600
+G1 X70.690 Y15.256 F600
+G1 X70.683 Y15.283 F600
+G1 X70.678 Y15.296 F600
+G1 X70.663 Y15.328 F600
+G1 X70.640 Y15.362 F600
+G1 X70.598 Y15.408 F600
+G1 X70.497 Y15.548 F600
+G1 X70.430 Y15.708 F600
+G1 X70.401 Y15.915 F600
+G1 X70.401 Y50.687 F600
+G1 X70.421 Y50.859 F600
+G1 X70.480 Y51.021 F600
+G1 X70.598 Y51.194 F600
+G1 X70.640 Y51.240 F600
+G1 X70.665 Y51.277 F600
+G1 X70.686 Y51.328 F600
+G1 X70.691 Y51.352 F600
+G1 X70.694 Y51.380 F600
+G1 X70.695 Y51.404 F600
+G1 X70.690 Y51.447 F600
+G1 X70.683 Y51.474 F600
+G1 X70.668 Y51.510 F600
+G1 X70.640 Y51.553 F600
+G1 X70.622 Y51.573 F600
+G1 X70.602 Y51.592 F600
+G1 X70.571 Y51.612 F600
+G1 X70.543 Y51.626 F600
+G1 X70.523 Y51.634 F600
+G1 X70.502 Y51.640 F600
+G1 X70.462 Y51.645 F600
+G1 X70.446 Y51.646 F600
+G1 X70.401 Y51.642 F600
+G1 X70.389 Y51.640 F600
+G1 X70.368 Y51.634 F600
+G1 X70.330 Y51.618 F600
+G1 X70.285 Y51.589 F600
+G1 X70.242 Y51.549 F600
+G1 X70.102 Y51.447 F600
+G1 X69.943 Y51.380 F600
+G1 X69.735 Y51.351 F600
+G1 X15.915 Y51.351 F600
+G1 X15.743 Y51.371 F600
+G1 X15.581 Y51.430 F600
+G1 X15.408 Y51.549 F600
+G1 X15.365 Y51.589 F600
+G1 X15.320 Y51.618 F600
+G1 X15.282 Y51.634 F600
+G1 X15.255 Y51.641 F600
+G1 X15.204 Y51.646 F600
+G1 Z-2.000 F200
+G1 X15.160 Y51.642 F600
+G1 X15.148 Y51.640 F600
+G1 X15.127 Y51.634 F600
+G1 X15.094 Y51.620 F600
+G1 X15.079 Y51.612 F600
+G1 X15.048 Y51.592 F600
+G1 X15.028 Y51.573 F600
+G1 X15.009 Y51.553 F600
+G1 X14.982 Y51.510 F600
+G1 X14.967 Y51.474 F600
+G1 X14.960 Y51.447 F600
+G1 X14.955 Y51.404 F600
+G1 X14.956 Y51.380 F600
+G1 X14.959 Y51.352 F600
+G1 X14.964 Y51.328 F600
+G1 X14.985 Y51.277 F600
+G1 X15.016 Y51.232 F600
+G1 X15.052 Y51.193 F600
+G1 X15.154 Y51.053 F600
+G1 X15.221 Y50.894 F600
+G1 X15.250 Y50.686 F600
+G1 X15.250 Y15.916 F600
+G1 X15.230 Y15.744 F600
+G1 X15.171 Y15.582 F600
+G1 X15.052 Y15.409 F600
+G1 X15.012 Y15.366 F600
+G1 X14.987 Y15.328 F600
+G1 X14.972 Y15.296 F600
+G1 X14.967 Y15.283 F600
+G1 X14.960 Y15.256 F600
+G1 X14.955 Y15.213 F600
+G1 X14.956 Y15.189 F600
+G1 X14.960 Y15.155 F600
+G1 X14.965 Y15.135 F600
+G1 X14.980 Y15.096 F600
+G1 X14.989 Y15.080 F600
+G1 X15.009 Y15.049 F600
+G1 X15.024 Y15.033 F600
+G1 X15.048 Y15.010 F600
+G1 X15.079 Y14.990 F600
+G1 X15.104 Y14.977 F600
+G1 X15.127 Y14.968 F600
+G1 X15.154 Y14.961 F600
+G1 X15.204 Y14.956 F600
+G1 X15.255 Y14.961 F600
+G1 X15.282 Y14.968 F600
+G1 X15.324 Y14.986 F600
+G1 X15.361 Y15.011 F600
+G1 X15.407 Y15.053 F600
+G1 X15.547 Y15.154 F600
+G1 X15.707 Y15.221 F600
+G1 X15.914 Y15.250 F600
+G1 X69.736 Y15.250 F600
+G1 X69.908 Y15.230 F600
+G1 X70.070 Y15.171 F600
+G1 X70.243 Y15.053 F600
+G1 X70.289 Y15.011 F600
+G1 X70.326 Y14.986 F600
+G1 X70.368 Y14.968 F600
+G1 X70.395 Y14.961 F600
+G1 X70.446 Y14.956 F600
+G1 X70.496 Y14.961 F600
+G1 X70.523 Y14.968 F600
+G1 X70.556 Y14.982 F600
+G1 X70.571 Y14.990 F600
+G1 X70.602 Y15.010 F600
+G1 X70.626 Y15.033 F600
+G1 X70.641 Y15.049 F600
+G1 X70.661 Y15.080 F600
+G1 X70.670 Y15.096 F600
+G1 X70.685 Y15.135 F600
+G1 X70.690 Y15.155 F600
+G1 X70.694 Y15.189 F600
+G1 X70.695 Y15.213 F600
+G1 X70.690 Y15.256 F600
+G1 X70.683 Y15.283 F600
+G1 X70.678 Y15.296 F600
+G1 X70.663 Y15.328 F600
+G1 X70.640 Y15.362 F600
+G1 X70.598 Y15.408 F600
+G1 X70.497 Y15.548 F600
+G1 X70.430 Y15.708 F600
+G1 X70.401 Y15.915 F600
+G1 X70.401 Y50.687 F600
+G1 X70.421 Y50.859 F600
+G1 X70.480 Y51.021 F600
+G1 X70.598 Y51.194 F600
+G1 X70.640 Y51.240 F600
+G1 X70.665 Y51.277 F600
+G1 X70.686 Y51.328 F600
+G1 X70.691 Y51.352 F600
+G1 X70.694 Y51.380 F600
+G1 X70.695 Y51.404 F600
+G1 X70.690 Y51.447 F600
+G1 X70.683 Y51.474 F600
+G1 X70.668 Y51.510 F600
+G1 X70.640 Y51.553 F600
+G1 X70.622 Y51.573 F600
+G1 X70.602 Y51.592 F600
+G1 X70.571 Y51.612 F600
+G1 X70.543 Y51.626 F600
+G1 X70.523 Y51.634 F600
+G1 X70.502 Y51.640 F600
+G1 X70.462 Y51.645 F600
+G1 X70.446 Y51.646 F600
+G1 X70.401 Y51.642 F600
+G1 X70.389 Y51.640 F600
+G1 X70.368 Y51.634 F600
+G1 X70.330 Y51.618 F600
+G1 X70.285 Y51.589 F600
+G1 X70.242 Y51.549 F600
+G1 X70.102 Y51.447 F600
+G1 X69.943 Y51.380 F600
+G1 X69.735 Y51.351 F600
+G1 X15.915 Y51.351 F600
+G1 X15.743 Y51.371 F600
+G1 X15.581 Y51.430 F600
+G1 X15.408 Y51.549 F600
+G1 X15.365 Y51.589 F600
+G1 X15.320 Y51.618 F600
+G1 X15.282 Y51.634 F600
+G1 X15.255 Y51.641 F600
+G1 X15.204 Y51.646 F600
+G1 Z-3.000 F200
+G1 X15.160 Y51.642 F600
+G1 X15.148 Y51.640 F600
+G1 X15.127 Y51.634 F600
+G1 X15.094 Y51.620 F600
+G1 X15.079 Y51.612 F600
+G1 X15.048 Y51.592 F600
+G1 X15.028 Y51.573 F600
+G1 X15.009 Y51.553 F600
+G1 X14.982 Y51.510 F600
+G1 X14.967 Y51.474 F600
+G1 X14.960 Y51.447 F600
+G1 X14.955 Y51.404 F600
+G1 X14.956 Y51.380 F600
+G1 X14.959 Y51.352 F600
+G1 X14.964 Y51.328 F600
+G1 X14.985 Y51.277 F600
+G1 X15.016 Y51.232 F600
+G1 X15.052 Y51.193 F600
+G1 X15.154 Y51.053 F600
+G1 X15.221 Y50.894 F600
+G1 X15.250 Y50.686 F600
+G1 X15.250 Y15.916 F600
+G1 X15.230 Y15.744 F600
+G1 X15.171 Y15.582 F600
+G1 X15.052 Y15.409 F600
+G1 X15.012 Y15.366 F600
+G1 X14.987 Y15.328 F600
+G1 X14.972 Y15.296 F600
+G1 X14.967 Y15.283 F600
+G1 X14.960 Y15.256 F600
+G1 X14.955 Y15.213 F600
+G1 X14.956 Y15.189 F600
+G1 X14.960 Y15.155 F600
+G1 X14.965 Y15.135 F600
+G1 X14.980 Y15.096 F600
+G1 X14.989 Y15.080 F600
+G1 X15.009 Y15.049 F600
+G1 X15.024 Y15.033 F600
+G1 X15.048 Y15.010 F600
+G1 X15.079 Y14.990 F600
+G1 X15.104 Y14.977 F600
+G1 X15.127 Y14.968 F600
+G1 X15.154 Y14.961 F600
+G1 X15.204 Y14.956 F600
+G1 X15.255 Y14.961 F600
+G1 X15.282 Y14.968 F600
+G1 X15.324 Y14.986 F600
+G1 X15.361 Y15.011 F600
+G1 X15.407 Y15.053 F600
+G1 X15.547 Y15.154 F600
+G1 X15.707 Y15.221 F600
+G1 X15.914 Y15.250 F600
+G1 X69.736 Y15.250 F600
+G1 X69.908 Y15.230 F600
+G1 X70.070 Y15.171 F600
+G1 X70.243 Y15.053 F600
+G1 X70.289 Y15.011 F600
+G1 X70.326 Y14.986 F600
+G1 X70.368 Y14.968 F600
+G1 X70.395 Y14.961 F600
+G1 X70.446 Y14.956 F600
+G1 X70.496 Y14.961 F600
+G1 X70.523 Y14.968 F600
+G1 X70.556 Y14.982 F600
+G1 X70.571 Y14.990 F600
+G1 X70.602 Y15.010 F600
+G1 X70.626 Y15.033 F600
+G1 X70.641 Y15.049 F600
+G1 X70.661 Y15.080 F600
+G1 X70.670 Y15.096 F600
+G1 X70.685 Y15.135 F600
+G1 X70.690 Y15.155 F600
+G1 X70.694 Y15.189 F600
+G1 X70.695 Y15.213 F600
+G1 X70.690 Y15.256 F600
+G1 X70.683 Y15.283 F600
+G1 X70.678 Y15.296 F600
+G1 X70.663 Y15.328 F600
+G1 X70.640 Y15.362 F600
+G1 X70.598 Y15.408 F600
+G1 X70.497 Y15.548 F600
+G1 X70.430 Y15.708 F600
+G1 X70.401 Y15.915 F600
+G1 X70.401 Y50.687 F600
+G1 X70.421 Y50.859 F600
+G1 X70.480 Y51.021 F600
+G1 X70.598 Y51.194 F600
+G1 X70.640 Y51.240 F600
+G1 X70.665 Y51.277 F600
+G1 X70.686 Y51.328 F600
+G1 X70.691 Y51.352 F600
+G1 X70.694 Y51.380 F600
+G1 X70.695 Y51.404 F600
+G1 X70.690 Y51.447 F600
+G1 X70.683 Y51.474 F600
+G1 X70.668 Y51.510 F600
+G1 X70.640 Y51.553 F600
+G1 X70.622 Y51.573 F600
+G1 X70.602 Y51.592 F600
+G1 X70.571 Y51.612 F600
+G1 X70.543 Y51.626 F600
+G1 X70.523 Y51.634 F600
+G1 X70.502 Y51.640 F600
+G1 X70.462 Y51.645 F600
+G1 X70.446 Y51.646 F600
+G1 X70.401 Y51.642 F600
+G1 X70.389 Y51.640 F600
+G1 X70.368 Y51.634 F600
+G1 X70.330 Y51.618 F600
+G1 X70.285 Y51.589 F600
+G1 X70.242 Y51.549 F600
+G1 X70.102 Y51.447 F600
+G1 X69.943 Y51.380 F600
+G1 X69.735 Y51.351 F600
+G1 X15.915 Y51.351 F600
+G1 X15.743 Y51.371 F600
+G1 X15.581 Y51.430 F600
+G1 X15.408 Y51.549 F600
+G1 X15.365 Y51.589 F600
+G1 X15.320 Y51.618 F600
+G1 X15.282 Y51.634 F600
+G1 X15.255 Y51.641 F600
+G1 X15.204 Y51.646 F600
+G0 Z5.000
+G0 X80.823 Y62.331
+G1 Z-1.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-2.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-3.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 Z-2.000 F200
+G1 X81.401 Y26.953 F600
+G1 Z-3.000 F200
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 Z-2.000 F200
+G1 X36.478 Y4.250 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 Z-2.000 F200
+G1 X4.250 Y39.648 F600
+G1 Z-3.000 F200
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 Z-2.000 F200
+G1 X49.173 Y62.351 F600
+G1 Z-3.000 F200
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
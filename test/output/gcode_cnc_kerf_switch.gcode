@@ -0,0 +1,2356 @@
+(gcode_cnc_kerf switch)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X17.729 Y49.121
+G1 Z-1.000 F200
+G1 X17.679 Y49.116 F600
+G1 X17.652 Y49.109 F600
+G1 X17.619 Y49.095 F600
+G1 X17.604 Y49.087 F600
+G1 X17.573 Y49.067 F600
+G1 X17.553 Y49.048 F600
+G1 X17.534 Y49.028 F600
+G1 X17.507 Y48.985 F600
+G1 X17.492 Y48.949 F600
+G1 X17.485 Y48.922 F600
+G1 X17.480 Y48.879 F600
+G1 X17.481 Y48.855 F600
+G1 X17.484 Y48.827 F600
+G1 X17.489 Y48.803 F600
+G1 X17.510 Y48.752 F600
+G1 X17.541 Y48.707 F600
+G1 X17.577 Y48.668 F600
+G1 X17.679 Y48.528 F600
+G1 X17.746 Y48.369 F600
+G1 X17.775 Y48.161 F600
+G1 X17.775 Y37.492 F600
+G1 X17.755 Y37.320 F600
+G1 X17.696 Y37.158 F600
+G1 X17.577 Y36.985 F600
+G1 X17.537 Y36.942 F600
+G1 X17.512 Y36.904 F600
+G1 X17.497 Y36.872 F600
+G1 X17.492 Y36.859 F600
+G1 X17.485 Y36.832 F600
+G1 X17.480 Y36.789 F600
+G1 X17.481 Y36.765 F600
+G1 X17.485 Y36.731 F600
+G1 X17.490 Y36.711 F600
+G1 X17.505 Y36.672 F600
+G1 X17.514 Y36.656 F600
+G1 X17.534 Y36.625 F600
+G1 X17.549 Y36.609 F600
+G1 X17.573 Y36.586 F600
+G1 X17.604 Y36.566 F600
+G1 X17.629 Y36.553 F600
+G1 X17.652 Y36.544 F600
+G1 X17.679 Y36.537 F600
+G1 X17.729 Y36.532 F600
+G1 X17.780 Y36.537 F600
+G1 X17.807 Y36.544 F600
+G1 X17.849 Y36.562 F600
+G1 X17.886 Y36.587 F600
+G1 X17.932 Y36.629 F600
+G1 X18.072 Y36.730 F600
+G1 X18.232 Y36.797 F600
+G1 X18.439 Y36.826 F600
+G1 X29.110 Y36.826 F600
+G1 X29.282 Y36.806 F600
+G1 X29.444 Y36.747 F600
+G1 X29.617 Y36.629 F600
+G1 X29.663 Y36.587 F600
+G1 X29.700 Y36.562 F600
+G1 X29.742 Y36.544 F600
+G1 X29.769 Y36.537 F600
+G1 X29.820 Y36.532 F600
+G1 X29.870 Y36.537 F600
+G1 X29.897 Y36.544 F600
+G1 X29.930 Y36.558 F600
+G1 X29.945 Y36.566 F600
+G1 X29.976 Y36.586 F600
+G1 X30.000 Y36.609 F600
+G1 X30.015 Y36.625 F600
+G1 X30.035 Y36.656 F600
+G1 X30.044 Y36.672 F600
+G1 X30.059 Y36.711 F600
+G1 X30.064 Y36.731 F600
+G1 X30.068 Y36.765 F600
+G1 X30.069 Y36.789 F600
+G1 X30.064 Y36.832 F600
+G1 X30.057 Y36.859 F600
+G1 X30.052 Y36.872 F600
+G1 X30.037 Y36.904 F600
+G1 X30.014 Y36.938 F600
+G1 X29.972 Y36.984 F600
+G1 X29.871 Y37.124 F600
+G1 X29.804 Y37.284 F600
+G1 X29.775 Y37.491 F600
+G1 X29.775 Y48.162 F600
+G1 X29.795 Y48.334 F600
+G1 X29.854 Y48.496 F600
+G1 X29.972 Y48.669 F600
+G1 X30.014 Y48.715 F600
+G1 X30.039 Y48.752 F600
+G1 X30.060 Y48.803 F600
+G1 X30.065 Y48.827 F600
+G1 X30.068 Y48.855 F600
+G1 X30.069 Y48.879 F600
+G1 X30.064 Y48.922 F600
+G1 X30.057 Y48.949 F600
+G1 X30.042 Y48.985 F600
+G1 X30.015 Y49.028 F600
+G1 X29.996 Y49.048 F600
+G1 X29.976 Y49.067 F600
+G1 X29.945 Y49.087 F600
+G1 X29.917 Y49.101 F600
+G1 X29.897 Y49.109 F600
+G1 X29.870 Y49.116 F600
+G1 X29.820 Y49.121 F600
+G1 X29.775 Y49.117 F600
+G1 X29.763 Y49.115 F600
+G1 X29.742 Y49.109 F600
+G1 X29.704 Y49.093 F600
+G1 X29.659 Y49.064 F600
+G1 X29.616 Y49.024 F600
+G1 X29.476 Y48.922 F600
+G1 X29.317 Y48.855 F600
+G1 X29.109 Y48.826 F600
+G1 X18.440 Y48.826 F600
+G1 X18.268 Y48.846 F600
+G1 X18.106 Y48.905 F600
+G1 X17.933 Y49.024 F600
+G1 X17.890 Y49.064 F600
+G1 X17.845 Y49.093 F600
+G1 X17.807 Y49.109 F600
+G1 X17.780 Y49.116 F600
+G1 X17.729 Y49.121 F600
+G1 Z-2.000 F200
+G1 X17.679 Y49.116 F600
+G1 X17.652 Y49.109 F600
+G1 X17.619 Y49.095 F600
+G1 X17.604 Y49.087 F600
+G1 X17.573 Y49.067 F600
+G1 X17.553 Y49.048 F600
+G1 X17.534 Y49.028 F600
+G1 X17.507 Y48.985 F600
+G1 X17.492 Y48.949 F600
+G1 X17.485 Y48.922 F600
+G1 X17.480 Y48.879 F600
+G1 X17.481 Y48.855 F600
+G1 X17.484 Y48.827 F600
+G1 X17.489 Y48.803 F600
+G1 X17.510 Y48.752 F600
+G1 X17.541 Y48.707 F600
+G1 X17.577 Y48.668 F600
+G1 X17.679 Y48.528 F600
+G1 X17.746 Y48.369 F600
+G1 X17.775 Y48.161 F600
+G1 X17.775 Y37.492 F600
+G1 X17.755 Y37.320 F600
+G1 X17.696 Y37.158 F600
+G1 X17.577 Y36.985 F600
+G1 X17.537 Y36.942 F600
+G1 X17.512 Y36.904 F600
+G1 X17.497 Y36.872 F600
+G1 X17.492 Y36.859 F600
+G1 X17.485 Y36.832 F600
+G1 X17.480 Y36.789 F600
+G1 X17.481 Y36.765 F600
+G1 X17.485 Y36.731 F600
+G1 X17.490 Y36.711 F600
+G1 X17.505 Y36.672 F600
+G1 X17.514 Y36.656 F600
+G1 X17.534 Y36.625 F600
+G1 X17.549 Y36.609 F600
+G1 X17.573 Y36.586 F600
+G1 X17.604 Y36.566 F600
+G1 X17.629 Y36.553 F600
+G1 X17.652 Y36.544 F600
+G1 X17.679 Y36.537 F600
+G1 X17.729 Y36.532 F600
+G1 X17.780 Y36.537 F600
+G1 X17.807 Y36.544 F600
+G1 X17.849 Y36.562 F600
+G1 X17.886 Y36.587 F600
+G1 X17.932 Y36.629 F600
+G1 X18.072 Y36.730 F600
+G1 X18.232 Y36.797 F600
+G1 X18.439 Y36.826 F600
+G1 X29.110 Y36.826 F600
+G1 X29.282 Y36.806 F600
+G1 X29.444 Y36.747 F600
+G1 X29.617 Y36.629 F600
+G1 X29.663 Y36.587 F600
+G1 X29.700 Y36.562 F600
+G1 X29.742 Y36.544 F600
+G1 X29.769 Y36.537 F600
+G1 X29.820 Y36.532 F600
+G1 X29.870 Y36.537 F600
+G1 X29.897 Y36.544 F600
+G1 X29.930 Y36.558 F600
+G1 X29.945 Y36.566 F600
+G1 X29.976 Y36.586 F600
+G1 X30.000 Y36.609 F600
+G1 X30.015 Y36.625 F600
+G1 X30.035 Y36.656 F600
+G1 X30.044 Y36.672 F600
+G1 X30.059 Y36.711 F600
+G1 X30.064 Y36.731 F600
+G1 X30.068 Y36.765 F600
+G1 X30.069 Y36.789 F600
+G1 X30.064 Y36.832 F600
+G1 X30.057 Y36.859 F600
+G1 X30.052 Y36.872 F600
+G1 X30.037 Y36.904 F600
+G1 X30.014 Y36.938 F600
+G1 X29.972 Y36.984 F600
+G1 X29.871 Y37.124 F600
+G1 X29.804 Y37.284 F600
+G1 X29.775 Y37.491 F600
+G1 X29.775 Y48.162 F600
+G1 X29.795 Y48.334 F600
+G1 X29.854 Y48.496 F600
+G1 X29.972 Y48.669 F600
+G1 X30.014 Y48.715 F600
+G1 X30.039 Y48.752 F600
+G1 X30.060 Y48.803 F600
+G1 X30.065 Y48.827 F600
+G1 X30.068 Y48.855 F600
+G1 X30.069 Y48.879 F600
+G1 X30.064 Y48.922 F600
+G1 X30.057 Y48.949 F600
+G1 X30.042 Y48.985 F600
+G1 X30.015 Y49.028 F600
+G1 X29.996 Y49.048 F600
+G1 X29.976 Y49.067 F600
+G1 X29.945 Y49.087 F600
+G1 X29.917 Y49.101 F600
+G1 X29.897 Y49.109 F600
+G1 X29.870 Y49.116 F600
+G1 X29.820 Y49.121 F600
+G1 X29.775 Y49.117 F600
+G1 X29.763 Y49.115 F600
+G1 X29.742 Y49.109 F600
+G1 X29.704 Y49.093 F600
+G1 X29.659 Y49.064 F600
+G1 X29.616 Y49.024 F600
+G1 X29.476 Y48.922 F600
+G1 X29.317 Y48.855 F600
+G1 X29.109 Y48.826 F600
+G1 X18.440 Y48.826 F600
+G1 X18.268 Y48.846 F600
+G1 X18.106 Y48.905 F600
+G1 X17.933 Y49.024 F600
+G1 X17.890 Y49.064 F600
+G1 X17.845 Y49.093 F600
+G1 X17.807 Y49.109 F600
+G1 X17.780 Y49.116 F600
+G1 X17.729 Y49.121 F600
+G1 Z-3.000 F200
+G1 X17.679 Y49.116 F600
+G1 X17.652 Y49.109 F600
+G1 X17.619 Y49.095 F600
+G1 X17.604 Y49.087 F600
+G1 X17.573 Y49.067 F600
+G1 X17.553 Y49.048 F600
+G1 X17.534 Y49.028 F600
+G1 X17.507 Y48.985 F600
+G1 X17.492 Y48.949 F600
+G1 X17.485 Y48.922 F600
+G1 X17.480 Y48.879 F600
+G1 X17.481 Y48.855 F600
+G1 X17.484 Y48.827 F600
+G1 X17.489 Y48.803 F600
+G1 X17.510 Y48.752 F600
+G1 X17.541 Y48.707 F600
+G1 X17.577 Y48.668 F600
+G1 X17.679 Y48.528 F600
+G1 X17.746 Y48.369 F600
+G1 X17.775 Y48.161 F600
+G1 X17.775 Y37.492 F600
+G1 X17.755 Y37.320 F600
+G1 X17.696 Y37.158 F600
+G1 X17.577 Y36.985 F600
+G1 X17.537 Y36.942 F600
+G1 X17.512 Y36.904 F600
+G1 X17.497 Y36.872 F600
+G1 X17.492 Y36.859 F600
+G1 X17.485 Y36.832 F600
+G1 X17.480 Y36.789 F600
+G1 X17.481 Y36.765 F600
+G1 X17.485 Y36.731 F600
+G1 X17.490 Y36.711 F600
+G1 X17.505 Y36.672 F600
+G1 X17.514 Y36.656 F600
+G1 X17.534 Y36.625 F600
+G1 X17.549 Y36.609 F600
+G1 X17.573 Y36.586 F600
+G1 X17.604 Y36.566 F600
+G1 X17.629 Y36.553 F600
+G1 X17.652 Y36.544 F600
+G1 X17.679 Y36.537 F600
+G1 X17.729 Y36.532 F600
+G1 X17.780 Y36.537 F600
+G1 X17.807 Y36.544 F600
+G1 X17.849 Y36.562 F600
+G1 X17.886 Y36.587 F600
+G1 X17.932 Y36.629 F600
+G1 X18.072 Y36.730 F600
+G1 X18.232 Y36.797 F600
+G1 X18.439 Y36.826 F600
+G1 X29.110 Y36.826 F600
+G1 X29.282 Y36.806 F600
+G1 X29.444 Y36.747 F600
+G1 X29.617 Y36.629 F600
+G1 X29.663 Y36.587 F600
+G1 X29.700 Y36.562 F600
+G1 X29.742 Y36.544 F600
+G1 X29.769 Y36.537 F600
+G1 X29.820 Y36.532 F600
+G1 X29.870 Y36.537 F600
+G1 X29.897 Y36.544 F600
+G1 X29.930 Y36.558 F600
+G1 X29.945 Y36.566 F600
+G1 X29.976 Y36.586 F600
+G1 X30.000 Y36.609 F600
+G1 X30.015 Y36.625 F600
+G1 X30.035 Y36.656 F600
+G1 X30.044 Y36.672 F600
+G1 X30.059 Y36.711 F600
+G1 X30.064 Y36.731 F600
+G1 X30.068 Y36.765 F600
+G1 X30.069 Y36.789 F600
+G1 X30.064 Y36.832 F600
+G1 X30.057 Y36.859 F600
+G1 X30.052 Y36.872 F600
+G1 X30.037 Y36.904 F600
+G1 X30.014 Y36.938 F600
+G1 X29.972 Y36.984 F600
+G1 X29.871 Y37.124 F600
+G1 X29.804 Y37.284 F600
+G1 X29.775 Y37.491 F600
+G1 X29.775 Y48.162 F600
+G1 X29.795 Y48.334 F600
+G1 X29.854 Y48.496 F600
+G1 X29.972 Y48.669 F600
+G1 X30.014 Y48.715 F600
+G1 X30.039 Y48.752 F600
+G1 X30.060 Y48.803 F600
+G1 X30.065 Y48.827 F600
+G1 X30.068 Y48.855 F600
+G1 X30.069 Y48.879 F600
+G1 X30.064 Y48.922 F600
+G1 X30.057 Y48.949 F600
+G1 X30.042 Y48.985 F600
+G1 X30.015 Y49.028 F600
+G1 X29.996 Y49.048 F600
+G1 X29.976 Y49.067 F600
+G1 X29.945 Y49.087 F600
+G1 X29.917 Y49.101 F600
+G1 X29.897 Y49.109 F600
+G1 X29.870 Y49.116 F600
+G1 X29.820 Y49.121 F600
+G1 X29.775 Y49.117 F600
+G1 X29.763 Y49.115 F600
+G1 X29.742 Y49.109 F600
+G1 X29.704 Y49.093 F600
+G1 X29.659 Y49.064 F600
+G1 X29.616 Y49.024 F600
+G1 X29.476 Y48.922 F600
+G1 X29.317 Y48.855 F600
+G1 X29.109 Y48.826 F600
+G1 X18.440 Y48.826 F600
+G1 X18.268 Y48.846 F600
+G1 X18.106 Y48.905 F600
+G1 X17.933 Y49.024 F600
+G1 X17.890 Y49.064 F600
+G1 X17.845 Y49.093 F600
+G1 X17.807 Y49.109 F600
+G1 X17.780 Y49.116 F600
+G1 X17.729 Y49.121 F600
+G0 Z5.000
+G0 X17.729 Y30.071
+G1 Z-1.000 F200
+G1 X17.679 Y30.066 F600
+G1 X17.652 Y30.059 F600
+G1 X17.619 Y30.045 F600
+G1 X17.604 Y30.037 F600
+G1 X17.573 Y30.017 F600
+G1 X17.553 Y29.998 F600
+G1 X17.534 Y29.978 F600
+G1 X17.507 Y29.935 F600
+G1 X17.492 Y29.899 F600
+G1 X17.485 Y29.872 F600
+G1 X17.480 Y29.829 F600
+G1 X17.481 Y29.805 F600
+G1 X17.484 Y29.777 F600
+G1 X17.489 Y29.753 F600
+G1 X17.510 Y29.702 F600
+G1 X17.541 Y29.657 F600
+G1 X17.577 Y29.618 F600
+G1 X17.679 Y29.478 F600
+G1 X17.746 Y29.319 F600
+G1 X17.775 Y29.111 F600
+G1 X17.775 Y18.442 F600
+G1 X17.755 Y18.270 F600
+G1 X17.696 Y18.108 F600
+G1 X17.577 Y17.935 F600
+G1 X17.537 Y17.892 F600
+G1 X17.512 Y17.854 F600
+G1 X17.497 Y17.822 F600
+G1 X17.492 Y17.809 F600
+G1 X17.485 Y17.782 F600
+G1 X17.480 Y17.739 F600
+G1 X17.481 Y17.715 F600
+G1 X17.485 Y17.681 F600
+G1 X17.490 Y17.661 F600
+G1 X17.505 Y17.622 F600
+G1 X17.514 Y17.606 F600
+G1 X17.534 Y17.575 F600
+G1 X17.549 Y17.559 F600
+G1 X17.573 Y17.536 F600
+G1 X17.604 Y17.516 F600
+G1 X17.629 Y17.503 F600
+G1 X17.652 Y17.494 F600
+G1 X17.679 Y17.487 F600
+G1 X17.729 Y17.482 F600
+G1 X17.780 Y17.487 F600
+G1 X17.807 Y17.494 F600
+G1 X17.849 Y17.512 F600
+G1 X17.886 Y17.537 F600
+G1 X17.932 Y17.579 F600
+G1 X18.072 Y17.680 F600
+G1 X18.232 Y17.747 F600
+G1 X18.439 Y17.776 F600
+G1 X29.110 Y17.776 F600
+G1 X29.282 Y17.756 F600
+G1 X29.444 Y17.697 F600
+G1 X29.617 Y17.579 F600
+G1 X29.663 Y17.537 F600
+G1 X29.700 Y17.512 F600
+G1 X29.742 Y17.494 F600
+G1 X29.769 Y17.487 F600
+G1 X29.820 Y17.482 F600
+G1 X29.870 Y17.487 F600
+G1 X29.897 Y17.494 F600
+G1 X29.930 Y17.508 F600
+G1 X29.945 Y17.516 F600
+G1 X29.976 Y17.536 F600
+G1 X30.000 Y17.559 F600
+G1 X30.015 Y17.575 F600
+G1 X30.035 Y17.606 F600
+G1 X30.044 Y17.622 F600
+G1 X30.059 Y17.661 F600
+G1 X30.064 Y17.681 F600
+G1 X30.068 Y17.715 F600
+G1 X30.069 Y17.739 F600
+G1 X30.064 Y17.782 F600
+G1 X30.057 Y17.809 F600
+G1 X30.052 Y17.822 F600
+G1 X30.037 Y17.854 F600
+G1 X30.014 Y17.888 F600
+G1 X29.972 Y17.934 F600
+G1 X29.871 Y18.074 F600
+G1 X29.804 Y18.234 F600
+G1 X29.775 Y18.441 F600
+G1 X29.775 Y29.112 F600
+G1 X29.795 Y29.284 F600
+G1 X29.854 Y29.446 F600
+G1 X29.972 Y29.619 F600
+G1 X30.014 Y29.665 F600
+G1 X30.039 Y29.702 F600
+G1 X30.060 Y29.753 F600
+G1 X30.065 Y29.777 F600
+G1 X30.068 Y29.805 F600
+G1 X30.069 Y29.829 F600
+G1 X30.064 Y29.872 F600
+G1 X30.057 Y29.899 F600
+G1 X30.042 Y29.935 F600
+G1 X30.015 Y29.978 F600
+G1 X29.996 Y29.998 F600
+G1 X29.976 Y30.017 F600
+G1 X29.945 Y30.037 F600
+G1 X29.917 Y30.051 F600
+G1 X29.897 Y30.059 F600
+G1 X29.870 Y30.066 F600
+G1 X29.820 Y30.071 F600
+G1 X29.775 Y30.067 F600
+G1 X29.763 Y30.065 F600
+G1 X29.742 Y30.059 F600
+G1 X29.704 Y30.043 F600
+G1 X29.659 Y30.014 F600
+G1 X29.616 Y29.974 F600
+G1 X29.476 Y29.872 F600
+G1 X29.317 Y29.805 F600
+G1 X29.109 Y29.776 F600
+G1 X18.440 Y29.776 F600
+G1 X18.268 Y29.796 F600
+G1 X18.106 Y29.855 F600
+G1 X17.933 Y29.974 F600
+G1 X17.890 Y30.014 F600
+G1 X17.845 Y30.043 F600
+G1 X17.807 Y30.059 F600
+G1 X17.780 Y30.066 F600
+G1 X17.729 Y30.071 F600
+G1 Z-2.000 F200
+G1 X17.679 Y30.066 F600
+G1 X17.652 Y30.059 F600
+G1 X17.619 Y30.045 F600
+G1 X17.604 Y30.037 F600
+G1 X17.573 Y30.017 F600
+G1 X17.553 Y29.998 F600
+G1 X17.534 Y29.978 F600
+G1 X17.507 Y29.935 F600
+G1 X17.492 Y29.899 F600
+G1 X17.485 Y29.872 F600
+G1 X17.480 Y29.829 F600
+G1 X17.481 Y29.805 F600
+G1 X17.484 Y29.777 F600
+G1 X17.489 Y29.753 F600
+G1 X17.510 Y29.702 F600
+G1 X17.541 Y29.657 F600
+G1 X17.577 Y29.618 F600
+G1 X17.679 Y29.478 F600
+G1 X17.746 Y29.319 F600
+G1 X17.775 Y29.111 F600
+G1 X17.775 Y18.442 F600
+G1 X17.755 Y18.270 F600
+G1 X17.696 Y18.108 F600
+G1 X17.577 Y17.935 F600
+G1 X17.537 Y17.892 F600
+G1 X17.512 Y17.854 F600
+G1 X17.497 Y17.822 F600
+G1 X17.492 Y17.809 F600
+G1 X17.485 Y17.782 F600
+G1 X17.480 Y17.739 F600
+G1 X17.481 Y17.715 F600
+G1 X17.485 Y17.681 F600
+G1 X17.490 Y17.661 F600
+G1 X17.505 Y17.622 F600
+G1 X17.514 Y17.606 F600
+G1 X17.534 Y17.575 F600
+G1 X17.549 Y17.559 F600
+G1 X17.573 Y17.536 F600
+G1 X17.604 Y17.516 F600
+G1 X17.629 Y17.503 F600
+G1 X17.652 Y17.494 F600
+G1 X17.679 Y17.487 F600
+G1 X17.729 Y17.482 F600
+G1 X17.780 Y17.487 F600
+G1 X17.807 Y17.494 F600
+G1 X17.849 Y17.512 F600
+G1 X17.886 Y17.537 F600
+G1 X17.932 Y17.579 F600
+G1 X18.072 Y17.680 F600
+G1 X18.232 Y17.747 F600
+G1 X18.439 Y17.776 F600
+G1 X29.110 Y17.776 F600
+G1 X29.282 Y17.756 F600
+G1 X29.444 Y17.697 F600
+G1 X29.617 Y17.579 F600
+G1 X29.663 Y17.537 F600
+G1 X29.700 Y17.512 F600
+G1 X29.742 Y17.494 F600
+G1 X29.769 Y17.487 F600
+G1 X29.820 Y17.482 F600
+G1 X29.870 Y17.487 F600
+G1 X29.897 Y17.494 F600
+G1 X29.930 Y17.508 F600
+G1 X29.945 Y17.516 F600
+G1 X29.976 Y17.536 F600
+G1 X30.000 Y17.559 F600
+G1 X30.015 Y17.575 F600
+G1 X30.035 Y17.606 F600
+G1 X30.044 Y17.622 F600
+G1 X30.059 Y17.661 F600
+G1 X30.064 Y17.681 F600
+G1 X30.068 Y17.715 F600
+G1 X30.069 Y17.739 F600
+G1 X30.064 Y17.782 F600
+G1 X30.057 Y17.809 F600
+G1 X30.052 Y17.822 F600
+G1 X30.037 Y17.854 F600
+G1 X30.014 Y17.888 F600
+G1 X29.972 Y17.934 F600
+G1 X29.871 Y18.074 F600
+G1 X29.804 Y18.234 F600
+G1 X29.775 Y18.441 F600
+G1 X29.775 Y29.112 F600
+G1 X29.795 Y29.284 F600
+G1 X29.854 Y29.446 F600
+G1 X29.972 Y29.619 F600
+G1 X30.014 Y29.665 F600
+G1 X30.039 Y29.702 F600
+G1 X30.060 Y29.753 F600
+G1 X30.065 Y29.777 F600
+G1 X30.068 Y29.805 F600
+G1 X30.069 Y29.829 F600
+G1 X30.064 Y29.872 F600
+G1 X30.057 Y29.899 F600
+G1 X30.042 Y29.935 F600
+G1 X30.015 Y29.978 F600
+G1 X29.996 Y29.998 F600
+G1 X29.976 Y30.017 F600
+G1 X29.945 Y30.037 F600
+G1 X29.917 Y30.051 F600
+G1 X29.897 Y30.059 F600
+G1 X29.870 Y30.066 F600
+G1 X29.820 Y30.071 F600
+G1 X29.775 Y30.067 F600
+G1 X29.763 Y30.065 F600
+G1 X29.742 Y30.059 F600
+G1 X29.704 Y30.043 F600
+G1 X29.659 Y30.014 F600
+G1 X29.616 Y29.974 F600
+G1 X29.476 Y29.872 F600
+G1 X29.317 Y29.805 F600
+G1 X29.109 Y29.776 F600
+G1 X18.440 Y29.776 F600
+G1 X18.268 Y29.796 F600
+G1 X18.106 Y29.855 F600
+G1 X17.933 Y29.974 F600
+G1 X17.890 Y30.014 F600
+G1 X17.845 Y30.043 F600
+G1 X17.807 Y30.059 F600
+G1 X17.780 Y30.066 F600
+G1 X17.729 Y30.071 F600
+G1 Z-3.000 F200
+G1 X17.679 Y30.066 F600
+G1 X17.652 Y30.059 F600
+G1 X17.619 Y30.045 F600
+G1 X17.604 Y30.037 F600
+G1 X17.573 Y30.017 F600
+G1 X17.553 Y29.998 F600
+G1 X17.534 Y29.978 F600
+G1 X17.507 Y29.935 F600
+G1 X17.492 Y29.899 F600
+G1 X17.485 Y29.872 F600
+G1 X17.480 Y29.829 F600
+G1 X17.481 Y29.805 F600
+G1 X17.484 Y29.777 F600
+G1 X17.489 Y29.753 F600
+G1 X17.510 Y29.702 F600
+G1 X17.541 Y29.657 F600
+G1 X17.577 Y29.618 F600
+G1 X17.679 Y29.478 F600
+G1 X17.746 Y29.319 F600
+G1 X17.775 Y29.111 F600
+G1 X17.775 Y18.442 F600
+G1 X17.755 Y18.270 F600
+G1 X17.696 Y18.108 F600
+G1 X17.577 Y17.935 F600
+G1 X17.537 Y17.892 F600
+G1 X17.512 Y17.854 F600
+G1 X17.497 Y17.822 F600
+G1 X17.492 Y17.809 F600
+G1 X17.485 Y17.782 F600
+G1 X17.480 Y17.739 F600
+G1 X17.481 Y17.715 F600
+G1 X17.485 Y17.681 F600
+G1 X17.490 Y17.661 F600
+G1 X17.505 Y17.622 F600
+G1 X17.514 Y17.606 F600
+G1 X17.534 Y17.575 F600
+G1 X17.549 Y17.559 F600
+G1 X17.573 Y17.536 F600
+G1 X17.604 Y17.516 F600
+G1 X17.629 Y17.503 F600
+G1 X17.652 Y17.494 F600
+G1 X17.679 Y17.487 F600
+G1 X17.729 Y17.482 F600
+G1 X17.780 Y17.487 F600
+G1 X17.807 Y17.494 F600
+G1 X17.849 Y17.512 F600
+G1 X17.886 Y17.537 F600
+G1 X17.932 Y17.579 F600
+G1 X18.072 Y17.680 F600
+G1 X18.232 Y17.747 F600
+G1 X18.439 Y17.776 F600
+G1 X29.110 Y17.776 F600
+G1 X29.282 Y17.756 F600
+G1 X29.444 Y17.697 F600
+G1 X29.617 Y17.579 F600
+G1 X29.663 Y17.537 F600
+G1 X29.700 Y17.512 F600
+G1 X29.742 Y17.494 F600
+G1 X29.769 Y17.487 F600
+G1 X29.820 Y17.482 F600
+G1 X29.870 Y17.487 F600
+G1 X29.897 Y17.494 F600
+G1 X29.930 Y17.508 F600
+G1 X29.945 Y17.516 F600
+G1 X29.976 Y17.536 F600
+G1 X30.000 Y17.559 F600
+G1 X30.015 Y17.575 F600
+G1 X30.035 Y17.606 F600
+G1 X30.044 Y17.622 F600
+G1 X30.059 Y17.661 F600
+G1 X30.064 Y17.681 F600
+G1 X30.068 Y17.715 F600
+G1 X30.069 Y17.739 F600
+G1 X30.064 Y17.782 F600
+G1 X30.057 Y17.809 F600
+G1 X30.052 Y17.822 F600
+G1 X30.037 Y17.854 F600
+G1 X30.014 Y17.888 F600
+G1 X29.972 Y17.934 F600
+G1 X29.871 Y18.074 F600
+G1 X29.804 Y18.234 F600
+G1 X29.775 Y18.441 F600
+G1 X29.775 Y29.112 F600
+G1 X29.795 Y29.284 F600
+G1 X29.854 Y29.446 F600
+G1 X29.972 Y29.619 F600
+G1 X30.014 Y29.665 F600
+G1 X30.039 Y29.702 F600
+G1 X30.060 Y29.753 F600
+G1 X30.065 Y29.777 F600
+G1 X30.068 Y29.805 F600
+G1 X30.069 Y29.829 F600
+G1 X30.064 Y29.872 F600
+G1 X30.057 Y29.899 F600
+G1 X30.042 Y29.935 F600
+G1 X30.015 Y29.978 F600
+G1 X29.996 Y29.998 F600
+G1 X29.976 Y30.017 F600
+G1 X29.945 Y30.037 F600
+G1 X29.917 Y30.051 F600
+G1 X29.897 Y30.059 F600
+G1 X29.870 Y30.066 F600
+G1 X29.820 Y30.071 F600
+G1 X29.775 Y30.067 F600
+G1 X29.763 Y30.065 F600
+G1 X29.742 Y30.059 F600
+G1 X29.704 Y30.043 F600
+G1 X29.659 Y30.014 F600
+G1 X29.616 Y29.974 F600
+G1 X29.476 Y29.872 F600
+G1 X29.317 Y29.805 F600
+G1 X29.109 Y29.776 F600
+G1 X18.440 Y29.776 F600
+G1 X18.268 Y29.796 F600
+G1 X18.106 Y29.855 F600
+G1 X17.933 Y29.974 F600
+G1 X17.890 Y30.014 F600
+G1 X17.845 Y30.043 F600
+G1 X17.807 Y30.059 F600
+G1 X17.780 Y30.066 F600
+G1 X17.729 Y30.071 F600
+G0 Z5.000
+G0 X36.779 Y30.071
+G1 Z-1.000 F200
+G1 X36.735 Y30.067 F600
+G1 X36.723 Y30.065 F600
+G1 X36.702 Y30.059 F600
+G1 X36.669 Y30.045 F600
+G1 X36.654 Y30.037 F600
+G1 X36.623 Y30.017 F600
+G1 X36.603 Y29.998 F600
+G1 X36.584 Y29.978 F600
+G1 X36.557 Y29.935 F600
+G1 X36.542 Y29.899 F600
+G1 X36.535 Y29.872 F600
+G1 X36.530 Y29.829 F600
+G1 X36.531 Y29.805 F600
+G1 X36.534 Y29.777 F600
+G1 X36.539 Y29.753 F600
+G1 X36.560 Y29.702 F600
+G1 X36.591 Y29.657 F600
+G1 X36.627 Y29.618 F600
+G1 X36.729 Y29.478 F600
+G1 X36.796 Y29.319 F600
+G1 X36.825 Y29.111 F600
+G1 X36.825 Y18.442 F600
+G1 X36.805 Y18.270 F600
+G1 X36.746 Y18.108 F600
+G1 X36.627 Y17.935 F600
+G1 X36.587 Y17.892 F600
+G1 X36.562 Y17.854 F600
+G1 X36.547 Y17.822 F600
+G1 X36.542 Y17.809 F600
+G1 X36.535 Y17.782 F600
+G1 X36.530 Y17.739 F600
+G1 X36.531 Y17.715 F600
+G1 X36.535 Y17.681 F600
+G1 X36.540 Y17.661 F600
+G1 X36.555 Y17.622 F600
+G1 X36.564 Y17.606 F600
+G1 X36.584 Y17.575 F600
+G1 X36.599 Y17.559 F600
+G1 X36.623 Y17.536 F600
+G1 X36.654 Y17.516 F600
+G1 X36.679 Y17.503 F600
+G1 X36.702 Y17.494 F600
+G1 X36.729 Y17.487 F600
+G1 X36.779 Y17.482 F600
+G1 X36.830 Y17.487 F600
+G1 X36.857 Y17.494 F600
+G1 X36.899 Y17.512 F600
+G1 X36.936 Y17.537 F600
+G1 X36.982 Y17.579 F600
+G1 X37.122 Y17.680 F600
+G1 X37.282 Y17.747 F600
+G1 X37.489 Y17.776 F600
+G1 X48.160 Y17.776 F600
+G1 X48.332 Y17.756 F600
+G1 X48.494 Y17.697 F600
+G1 X48.667 Y17.579 F600
+G1 X48.713 Y17.537 F600
+G1 X48.750 Y17.512 F600
+G1 X48.792 Y17.494 F600
+G1 X48.819 Y17.487 F600
+G1 X48.870 Y17.482 F600
+G1 X48.920 Y17.487 F600
+G1 X48.947 Y17.494 F600
+G1 X48.980 Y17.508 F600
+G1 X48.995 Y17.516 F600
+G1 X49.026 Y17.536 F600
+G1 X49.050 Y17.559 F600
+G1 X49.065 Y17.575 F600
+G1 X49.085 Y17.606 F600
+G1 X49.094 Y17.622 F600
+G1 X49.109 Y17.661 F600
+G1 X49.114 Y17.681 F600
+G1 X49.118 Y17.715 F600
+G1 X49.119 Y17.739 F600
+G1 X49.114 Y17.782 F600
+G1 X49.107 Y17.809 F600
+G1 X49.102 Y17.822 F600
+G1 X49.087 Y17.854 F600
+G1 X49.064 Y17.888 F600
+G1 X49.022 Y17.934 F600
+G1 X48.921 Y18.074 F600
+G1 X48.854 Y18.234 F600
+G1 X48.825 Y18.441 F600
+G1 X48.825 Y29.112 F600
+G1 X48.845 Y29.284 F600
+G1 X48.904 Y29.446 F600
+G1 X49.022 Y29.619 F600
+G1 X49.064 Y29.665 F600
+G1 X49.089 Y29.702 F600
+G1 X49.110 Y29.753 F600
+G1 X49.115 Y29.777 F600
+G1 X49.118 Y29.805 F600
+G1 X49.119 Y29.829 F600
+G1 X49.114 Y29.872 F600
+G1 X49.107 Y29.899 F600
+G1 X49.092 Y29.935 F600
+G1 X49.065 Y29.978 F600
+G1 X49.046 Y29.998 F600
+G1 X49.026 Y30.017 F600
+G1 X48.995 Y30.037 F600
+G1 X48.967 Y30.051 F600
+G1 X48.947 Y30.059 F600
+G1 X48.920 Y30.066 F600
+G1 X48.870 Y30.071 F600
+G1 X48.825 Y30.067 F600
+G1 X48.813 Y30.065 F600
+G1 X48.792 Y30.059 F600
+G1 X48.754 Y30.043 F600
+G1 X48.709 Y30.014 F600
+G1 X48.666 Y29.974 F600
+G1 X48.526 Y29.872 F600
+G1 X48.367 Y29.805 F600
+G1 X48.159 Y29.776 F600
+G1 X37.490 Y29.776 F600
+G1 X37.318 Y29.796 F600
+G1 X37.156 Y29.855 F600
+G1 X36.983 Y29.974 F600
+G1 X36.940 Y30.014 F600
+G1 X36.895 Y30.043 F600
+G1 X36.857 Y30.059 F600
+G1 X36.830 Y30.066 F600
+G1 X36.779 Y30.071 F600
+G1 Z-2.000 F200
+G1 X36.735 Y30.067 F600
+G1 X36.723 Y30.065 F600
+G1 X36.702 Y30.059 F600
+G1 X36.669 Y30.045 F600
+G1 X36.654 Y30.037 F600
+G1 X36.623 Y30.017 F600
+G1 X36.603 Y29.998 F600
+G1 X36.584 Y29.978 F600
+G1 X36.557 Y29.935 F600
+G1 X36.542 Y29.899 F600
+G1 X36.535 Y29.872 F600
+G1 X36.530 Y29.829 F600
+G1 X36.531 Y29.805 F600
+G1 X36.534 Y29.777 F600
+G1 X36.539 Y29.753 F600
+G1 X36.560 Y29.702 F600
+G1 X36.591 Y29.657 F600
+G1 X36.627 Y29.618 F600
+G1 X36.729 Y29.478 F600
+G1 X36.796 Y29.319 F600
+G1 X36.825 Y29.111 F600
+G1 X36.825 Y18.442 F600
+G1 X36.805 Y18.270 F600
+G1 X36.746 Y18.108 F600
+G1 X36.627 Y17.935 F600
+G1 X36.587 Y17.892 F600
+G1 X36.562 Y17.854 F600
+G1 X36.547 Y17.822 F600
+G1 X36.542 Y17.809 F600
+G1 X36.535 Y17.782 F600
+G1 X36.530 Y17.739 F600
+G1 X36.531 Y17.715 F600
+G1 X36.535 Y17.681 F600
+G1 X36.540 Y17.661 F600
+G1 X36.555 Y17.622 F600
+G1 X36.564 Y17.606 F600
+G1 X36.584 Y17.575 F600
+G1 X36.599 Y17.559 F600
+G1 X36.623 Y17.536 F600
+G1 X36.654 Y17.516 F600
+G1 X36.679 Y17.503 F600
+G1 X36.702 Y17.494 F600
+G1 X36.729 Y17.487 F600
+G1 X36.779 Y17.482 F600
+G1 X36.830 Y17.487 F600
+G1 X36.857 Y17.494 F600
+G1 X36.899 Y17.512 F600
+G1 X36.936 Y17.537 F600
+G1 X36.982 Y17.579 F600
+G1 X37.122 Y17.680 F600
+G1 X37.282 Y17.747 F600
+G1 X37.489 Y17.776 F600
+G1 X48.160 Y17.776 F600
+G1 X48.332 Y17.756 F600
+G1 X48.494 Y17.697 F600
+G1 X48.667 Y17.579 F600
+G1 X48.713 Y17.537 F600
+G1 X48.750 Y17.512 F600
+G1 X48.792 Y17.494 F600
+G1 X48.819 Y17.487 F600
+G1 X48.870 Y17.482 F600
+G1 X48.920 Y17.487 F600
+G1 X48.947 Y17.494 F600
+G1 X48.980 Y17.508 F600
+G1 X48.995 Y17.516 F600
+G1 X49.026 Y17.536 F600
+G1 X49.050 Y17.559 F600
+G1 X49.065 Y17.575 F600
+G1 X49.085 Y17.606 F600
+G1 X49.094 Y17.622 F600
+G1 X49.109 Y17.661 F600
+G1 X49.114 Y17.681 F600
+G1 X49.118 Y17.715 F600
+G1 X49.119 Y17.739 F600
+G1 X49.114 Y17.782 F600
+G1 X49.107 Y17.809 F600
+G1 X49.102 Y17.822 F600
+G1 X49.087 Y17.854 F600
+G1 X49.064 Y17.888 F600
+G1 X49.022 Y17.934 F600
+G1 X48.921 Y18.074 F600
+G1 X48.854 Y18.234 F600
+G1 X48.825 Y18.441 F600
+G1 X48.825 Y29.112 F600
+G1 X48.845 Y29.284 F600
+G1 X48.904 Y29.446 F600
+G1 X49.022 Y29.619 F600
+G1 X49.064 Y29.665 F600
+G1 X49.089 Y29.702 F600
+G1 X49.110 Y29.753 F600
+G1 X49.115 Y29.777 F600
+G1 X49.118 Y29.805 F600
+G1 X49.119 Y29.829 F600
+G1 X49.114 Y29.872 F600
+G1 X49.107 Y29.899 F600
+G1 X49.092 Y29.935 F600
+G1 X49.065 Y29.978 F600
+G1 X49.046 Y29.998 F600
+G1 X49.026 Y30.017 F600
+G1 X48.995 Y30.037 F600
+G1 X48.967 Y30.051 F600
+G1 X48.947 Y30.059 F600
+G1 X48.920 Y30.066 F600
+G1 X48.870 Y30.071 F600
+G1 X48.825 Y30.067 F600
+G1 X48.813 Y30.065 F600
+G1 X48.792 Y30.059 F600
+G1 X48.754 Y30.043 F600
+G1 X48.709 Y30.014 F600
+G1 X48.666 Y29.974 F600
+G1 X48.526 Y29.872 F600
+G1 X48.367 Y29.805 F600
+G1 X48.159 Y29.776 F600
+G1 X37.490 Y29.776 F600
+G1 X37.318 Y29.796 F600
+G1 X37.156 Y29.855 F600
+G1 X36.983 Y29.974 F600
+G1 X36.940 Y30.014 F600
+G1 X36.895 Y30.043 F600
+G1 X36.857 Y30.059 F600
+G1 X36.830 Y30.066 F600
+G1 X36.779 Y30.071 F600
+G1 Z-3.000 F200
+G1 X36.735 Y30.067 F600
+G1 X36.723 Y30.065 F600
+G1 X36.702 Y30.059 F600
+G1 X36.669 Y30.045 F600
+G1 X36.654 Y30.037 F600
+G1 X36.623 Y30.017 F600
+G1 X36.603 Y29.998 F600
+G1 X36.584 Y29.978 F600
+G1 X36.557 Y29.935 F600
+G1 X36.542 Y29.899 F600
+G1 X36.535 Y29.872 F600
+G1 X36.530 Y29.829 F600
+G1 X36.531 Y29.805 F600
+G1 X36.534 Y29.777 F600
+G1 X36.539 Y29.753 F600
+G1 X36.560 Y29.702 F600
+G1 X36.591 Y29.657 F600
+G1 X36.627 Y29.618 F600
+G1 X36.729 Y29.478 F600
+G1 X36.796 Y29.319 F600
+G1 X36.825 Y29.111 F600
+G1 X36.825 Y18.442 F600
+G1 X36.805 Y18.270 F600
+G1 X36.746 Y18.108 F600
+G1 X36.627 Y17.935 F600
+G1 X36.587 Y17.892 F600
+G1 X36.562 Y17.854 F600
+G1 X36.547 Y17.822 F600
+G1 X36.542 Y17.809 F600
+G1 X36.535 Y17.782 F600
+G1 X36.530 Y17.739 F600
+G1 X36.531 Y17.715 F600
+G1 X36.535 Y17.681 F600
+G1 X36.540 Y17.661 F600
+G1 X36.555 Y17.622 F600
+G1 X36.564 Y17.606 F600
+G1 X36.584 Y17.575 F600
+G1 X36.599 Y17.559 F600
+G1 X36.623 Y17.536 F600
+G1 X36.654 Y17.516 F600
+G1 X36.679 Y17.503 F600
+G1 X36.702 Y17.494 F600
+G1 X36.729 Y17.487 F600
+G1 X36.779 Y17.482 F600
+G1 X36.830 Y17.487 F600
+G1 X36.857 Y17.494 F600
+G1 X36.899 Y17.512 F600
+G1 X36.936 Y17.537 F600
+G1 X36.982 Y17.579 F600
+G1 X37.122 Y17.680 F600
+G1 X37.282 Y17.747 F600
+G1 X37.489 Y17.776 F600
+G1 X48.160 Y17.776 F600
+G1 X48.332 Y17.756 F600
+G1 X48.494 Y17.697 F600
+G1 X48.667 Y17.579 F600
+G1 X48.713 Y17.537 F600
+G1 X48.750 Y17.512 F600
+G1 X48.792 Y17.494 F600
+G1 X48.819 Y17.487 F600
+G1 X48.870 Y17.482 F600
+G1 X48.920 Y17.487 F600
+G1 X48.947 Y17.494 F600
+G1 X48.980 Y17.508 F600
+G1 X48.995 Y17.516 F600
+G1 X49.026 Y17.536 F600
+G1 X49.050 Y17.559 F600
+G1 X49.065 Y17.575 F600
+G1 X49.085 Y17.606 F600
+G1 X49.094 Y17.622 F600
+G1 X49.109 Y17.661 F600
+G1 X49.114 Y17.681 F600
+G1 X49.118 Y17.715 F600
+G1 X49.119 Y17.739 F600
+G1 X49.114 Y17.782 F600
+G1 X49.107 Y17.809 F600
+G1 X49.102 Y17.822 F600
+G1 X49.087 Y17.854 F600
+G1 X49.064 Y17.888 F600
+G1 X49.022 Y17.934 F600
+G1 X48.921 Y18.074 F600
+G1 X48.854 Y18.234 F600
+G1 X48.825 Y18.441 F600
+G1 X48.825 Y29.112 F600
+G1 X48.845 Y29.284 F600
+G1 X48.904 Y29.446 F600
+G1 X49.022 Y29.619 F600
+G1 X49.064 Y29.665 F600
+G1 X49.089 Y29.702 F600
+G1 X49.110 Y29.753 F600
+G1 X49.115 Y29.777 F600
+G1 X49.118 Y29.805 F600
+G1 X49.119 Y29.829 F600
+G1 X49.114 Y29.872 F600
+G1 X49.107 Y29.899 F600
+G1 X49.092 Y29.935 F600
+G1 X49.065 Y29.978 F600
+G1 X49.046 Y29.998 F600
+G1 X49.026 Y30.017 F600
+G1 X48.995 Y30.037 F600
+G1 X48.967 Y30.051 F600
+G1 X48.947 Y30.059 F600
+G1 X48.920 Y30.066 F600
+G1 X48.870 Y30.071 F600
+G1 X48.825 Y30.067 F600
+G1 X48.813 Y30.065 F600
+G1 X48.792 Y30.059 F600
+G1 X48.754 Y30.043 F600
+G1 X48.709 Y30.014 F600
+G1 X48.666 Y29.974 F600
+G1 X48.526 Y29.872 F600
+G1 X48.367 Y29.805 F600
+G1 X48.159 Y29.776 F600
+G1 X37.490 Y29.776 F600
+G1 X37.318 Y29.796 F600
+G1 X37.156 Y29.855 F600
+G1 X36.983 Y29.974 F600
+G1 X36.940 Y30.014 F600
+G1 X36.895 Y30.043 F600
+G1 X36.857 Y30.059 F600
+G1 X36.830 Y30.066 F600
+G1 X36.779 Y30.071 F600
+G0 Z5.000
+G0 X55.829 Y30.071
+G1 Z-1.000 F200
+G1 X55.779 Y30.066 F600
+G1 X55.752 Y30.059 F600
+G1 X55.719 Y30.045 F600
+G1 X55.704 Y30.037 F600
+G1 X55.673 Y30.017 F600
+G1 X55.653 Y29.998 F600
+G1 X55.634 Y29.978 F600
+G1 X55.607 Y29.935 F600
+G1 X55.592 Y29.899 F600
+G1 X55.585 Y29.872 F600
+G1 X55.580 Y29.829 F600
+G1 X55.581 Y29.805 F600
+G1 X55.584 Y29.777 F600
+G1 X55.589 Y29.753 F600
+G1 X55.610 Y29.702 F600
+G1 X55.641 Y29.657 F600
+G1 X55.677 Y29.618 F600
+G1 X55.779 Y29.478 F600
+G1 X55.846 Y29.319 F600
+G1 X55.875 Y29.111 F600
+G1 X55.875 Y18.442 F600
+G1 X55.855 Y18.270 F600
+G1 X55.796 Y18.108 F600
+G1 X55.677 Y17.935 F600
+G1 X55.637 Y17.892 F600
+G1 X55.612 Y17.854 F600
+G1 X55.597 Y17.822 F600
+G1 X55.592 Y17.809 F600
+G1 X55.585 Y17.782 F600
+G1 X55.580 Y17.739 F600
+G1 X55.581 Y17.715 F600
+G1 X55.585 Y17.681 F600
+G1 X55.590 Y17.661 F600
+G1 X55.605 Y17.622 F600
+G1 X55.614 Y17.606 F600
+G1 X55.634 Y17.575 F600
+G1 X55.649 Y17.559 F600
+G1 X55.673 Y17.536 F600
+G1 X55.704 Y17.516 F600
+G1 X55.729 Y17.503 F600
+G1 X55.752 Y17.494 F600
+G1 X55.779 Y17.487 F600
+G1 X55.829 Y17.482 F600
+G1 X55.880 Y17.487 F600
+G1 X55.907 Y17.494 F600
+G1 X55.949 Y17.512 F600
+G1 X55.986 Y17.537 F600
+G1 X56.032 Y17.579 F600
+G1 X56.172 Y17.680 F600
+G1 X56.332 Y17.747 F600
+G1 X56.539 Y17.776 F600
+G1 X67.210 Y17.776 F600
+G1 X67.382 Y17.756 F600
+G1 X67.544 Y17.697 F600
+G1 X67.717 Y17.579 F600
+G1 X67.763 Y17.537 F600
+G1 X67.800 Y17.512 F600
+G1 X67.842 Y17.494 F600
+G1 X67.869 Y17.487 F600
+G1 X67.920 Y17.482 F600
+G1 X67.970 Y17.487 F600
+G1 X67.997 Y17.494 F600
+G1 X68.030 Y17.508 F600
+G1 X68.045 Y17.516 F600
+G1 X68.076 Y17.536 F600
+G1 X68.100 Y17.559 F600
+G1 X68.115 Y17.575 F600
+G1 X68.135 Y17.606 F600
+G1 X68.144 Y17.622 F600
+G1 X68.159 Y17.661 F600
+G1 X68.164 Y17.681 F600
+G1 X68.168 Y17.715 F600
+G1 X68.169 Y17.739 F600
+G1 X68.164 Y17.782 F600
+G1 X68.157 Y17.809 F600
+G1 X68.152 Y17.822 F600
+G1 X68.137 Y17.854 F600
+G1 X68.114 Y17.888 F600
+G1 X68.072 Y17.934 F600
+G1 X67.971 Y18.074 F600
+G1 X67.904 Y18.234 F600
+G1 X67.875 Y18.441 F600
+G1 X67.875 Y29.112 F600
+G1 X67.895 Y29.284 F600
+G1 X67.954 Y29.446 F600
+G1 X68.072 Y29.619 F600
+G1 X68.114 Y29.665 F600
+G1 X68.139 Y29.702 F600
+G1 X68.160 Y29.753 F600
+G1 X68.165 Y29.777 F600
+G1 X68.168 Y29.805 F600
+G1 X68.169 Y29.829 F600
+G1 X68.164 Y29.872 F600
+G1 X68.157 Y29.899 F600
+G1 X68.142 Y29.935 F600
+G1 X68.114 Y29.978 F600
+G1 X68.096 Y29.998 F600
+G1 X68.076 Y30.017 F600
+G1 X68.045 Y30.037 F600
+G1 X68.017 Y30.051 F600
+G1 X67.997 Y30.059 F600
+G1 X67.976 Y30.065 F600
+G1 X67.936 Y30.070 F600
+G1 X67.920 Y30.071 F600
+G1 X67.869 Y30.066 F600
+G1 X67.842 Y30.059 F600
+G1 X67.804 Y30.043 F600
+G1 X67.759 Y30.014 F600
+G1 X67.716 Y29.974 F600
+G1 X67.576 Y29.872 F600
+G1 X67.417 Y29.805 F600
+G1 X67.209 Y29.776 F600
+G1 X56.540 Y29.776 F600
+G1 X56.368 Y29.796 F600
+G1 X56.206 Y29.855 F600
+G1 X56.033 Y29.974 F600
+G1 X55.990 Y30.014 F600
+G1 X55.945 Y30.043 F600
+G1 X55.907 Y30.059 F600
+G1 X55.880 Y30.066 F600
+G1 X55.829 Y30.071 F600
+G1 Z-2.000 F200
+G1 X55.779 Y30.066 F600
+G1 X55.752 Y30.059 F600
+G1 X55.719 Y30.045 F600
+G1 X55.704 Y30.037 F600
+G1 X55.673 Y30.017 F600
+G1 X55.653 Y29.998 F600
+G1 X55.634 Y29.978 F600
+G1 X55.607 Y29.935 F600
+G1 X55.592 Y29.899 F600
+G1 X55.585 Y29.872 F600
+G1 X55.580 Y29.829 F600
+G1 X55.581 Y29.805 F600
+G1 X55.584 Y29.777 F600
+G1 X55.589 Y29.753 F600
+G1 X55.610 Y29.702 F600
+G1 X55.641 Y29.657 F600
+G1 X55.677 Y29.618 F600
+G1 X55.779 Y29.478 F600
+G1 X55.846 Y29.319 F600
+G1 X55.875 Y29.111 F600
+G1 X55.875 Y18.442 F600
+G1 X55.855 Y18.270 F600
+G1 X55.796 Y18.108 F600
+G1 X55.677 Y17.935 F600
+G1 X55.637 Y17.892 F600
+G1 X55.612 Y17.854 F600
+G1 X55.597 Y17.822 F600
+G1 X55.592 Y17.809 F600
+G1 X55.585 Y17.782 F600
+G1 X55.580 Y17.739 F600
+G1 X55.581 Y17.715 F600
+G1 X55.585 Y17.681 F600
+G1 X55.590 Y17.661 F600
+G1 X55.605 Y17.622 F600
+G1 X55.614 Y17.606 F600
+G1 X55.634 Y17.575 F600
+G1 X55.649 Y17.559 F600
+G1 X55.673 Y17.536 F600
+G1 X55.704 Y17.516 F600
+G1 X55.729 Y17.503 F600
+G1 X55.752 Y17.494 F600
+G1 X55.779 Y17.487 F600
+G1 X55.829 Y17.482 F600
+G1 X55.880 Y17.487 F600
+G1 X55.907 Y17.494 F600
+G1 X55.949 Y17.512 F600
+G1 X55.986 Y17.537 F600
+G1 X56.032 Y17.579 F600
+G1 X56.172 Y17.680 F600
+G1 X56.332 Y17.747 F600
+G1 X56.539 Y17.776 F600
+G1 X67.210 Y17.776 F600
+G1 X67.382 Y17.756 F600
+G1 X67.544 Y17.697 F600
+G1 X67.717 Y17.579 F600
+G1 X67.763 Y17.537 F600
+G1 X67.800 Y17.512 F600
+G1 X67.842 Y17.494 F600
+G1 X67.869 Y17.487 F600
+G1 X67.920 Y17.482 F600
+G1 X67.970 Y17.487 F600
+G1 X67.997 Y17.494 F600
+G1 X68.030 Y17.508 F600
+G1 X68.045 Y17.516 F600
+G1 X68.076 Y17.536 F600
+G1 X68.100 Y17.559 F600
+G1 X68.115 Y17.575 F600
+G1 X68.135 Y17.606 F600
+G1 X68.144 Y17.622 F600
+G1 X68.159 Y17.661 F600
+G1 X68.164 Y17.681 F600
+G1 X68.168 Y17.715 F600
+G1 X68.169 Y17.739 F600
+G1 X68.164 Y17.782 F600
+G1 X68.157 Y17.809 F600
+G1 X68.152 Y17.822 F600
+G1 X68.137 Y17.854 F600
+G1 X68.114 Y17.888 F600
+G1 X68.072 Y17.934 F600
+G1 X67.971 Y18.074 F600
+G1 X67.904 Y18.234 F600
+G1 X67.875 Y18.441 F600
+G1 X67.875 Y29.112 F600
+G1 X67.895 Y29.284 F600
+G1 X67.954 Y29.446 F600
+G1 X68.072 Y29.619 F600
+G1 X68.114 Y29.665 F600
+G1 X68.139 Y29.702 F600
+G1 X68.160 Y29.753 F600
+G1 X68.165 Y29.777 F600
+G1 X68.168 Y29.805 F600
+G1 X68.169 Y29.829 F600
+G1 X68.164 Y29.872 F600
+G1 X68.157 Y29.899 F600
+G1 X68.142 Y29.935 F600
+G1 X68.114 Y29.978 F600
+G1 X68.096 Y29.998 F600
+G1 X68.076 Y30.017 F600
+G1 X68.045 Y30.037 F600
+G1 X68.017 Y30.051 F600
+G1 X67.997 Y30.059 F600
+G1 X67.976 Y30.065 F600
+G1 X67.936 Y30.070 F600
+G1 X67.920 Y30.071 F600
+G1 X67.869 Y30.066 F600
+G1 X67.842 Y30.059 F600
+G1 X67.804 Y30.043 F600
+G1 X67.759 Y30.014 F600
+G1 X67.716 Y29.974 F600
+G1 X67.576 Y29.872 F600
+G1 X67.417 Y29.805 F600
+G1 X67.209 Y29.776 F600
+G1 X56.540 Y29.776 F600
+G1 X56.368 Y29.796 F600
+G1 X56.206 Y29.855 F600
+G1 X56.033 Y29.974 F600
+G1 X55.990 Y30.014 F600
+G1 X55.945 Y30.043 F600
+G1 X55.907 Y30.059 F600
+G1 X55.880 Y30.066 F600
+G1 X55.829 Y30.071 F600
+G1 Z-3.000 F200
+G1 X55.779 Y30.066 F600
+G1 X55.752 Y30.059 F600
+G1 X55.719 Y30.045 F600
+G1 X55.704 Y30.037 F600
+G1 X55.673 Y30.017 F600
+G1 X55.653 Y29.998 F600
+G1 X55.634 Y29.978 F600
+G1 X55.607 Y29.935 F600
+G1 X55.592 Y29.899 F600
+G1 X55.585 Y29.872 F600
+G1 X55.580 Y29.829 F600
+G1 X55.581 Y29.805 F600
+G1 X55.584 Y29.777 F600
+G1 X55.589 Y29.753 F600
+G1 X55.610 Y29.702 F600
+G1 X55.641 Y29.657 F600
+G1 X55.677 Y29.618 F600
+G1 X55.779 Y29.478 F600
+G1 X55.846 Y29.319 F600
+G1 X55.875 Y29.111 F600
+G1 X55.875 Y18.442 F600
+G1 X55.855 Y18.270 F600
+G1 X55.796 Y18.108 F600
+G1 X55.677 Y17.935 F600
+G1 X55.637 Y17.892 F600
+G1 X55.612 Y17.854 F600
+G1 X55.597 Y17.822 F600
+G1 X55.592 Y17.809 F600
+G1 X55.585 Y17.782 F600
+G1 X55.580 Y17.739 F600
+G1 X55.581 Y17.715 F600
+G1 X55.585 Y17.681 F600
+G1 X55.590 Y17.661 F600
+G1 X55.605 Y17.622 F600
+G1 X55.614 Y17.606 F600
+G1 X55.634 Y17.575 F600
+G1 X55.649 Y17.559 F600
+G1 X55.673 Y17.536 F600
+G1 X55.704 Y17.516 F600
+G1 X55.729 Y17.503 F600
+G1 X55.752 Y17.494 F600
+G1 X55.779 Y17.487 F600
+G1 X55.829 Y17.482 F600
+G1 X55.880 Y17.487 F600
+G1 X55.907 Y17.494 F600
+G1 X55.949 Y17.512 F600
+G1 X55.986 Y17.537 F600
+G1 X56.032 Y17.579 F600
+G1 X56.172 Y17.680 F600
+G1 X56.332 Y17.747 F600
+G1 X56.539 Y17.776 F600
+G1 X67.210 Y17.776 F600
+G1 X67.382 Y17.756 F600
+G1 X67.544 Y17.697 F600
+G1 X67.717 Y17.579 F600
+G1 X67.763 Y17.537 F600
+G1 X67.800 Y17.512 F600
+G1 X67.842 Y17.494 F600
+G1 X67.869 Y17.487 F600
+G1 X67.920 Y17.482 F600
+G1 X67.970 Y17.487 F600
+G1 X67.997 Y17.494 F600
+G1 X68.030 Y17.508 F600
+G1 X68.045 Y17.516 F600
+G1 X68.076 Y17.536 F600
+G1 X68.100 Y17.559 F600
+G1 X68.115 Y17.575 F600
+G1 X68.135 Y17.606 F600
+G1 X68.144 Y17.622 F600
+G1 X68.159 Y17.661 F600
+G1 X68.164 Y17.681 F600
+G1 X68.168 Y17.715 F600
+G1 X68.169 Y17.739 F600
+G1 X68.164 Y17.782 F600
+G1 X68.157 Y17.809 F600
+G1 X68.152 Y17.822 F600
+G1 X68.137 Y17.854 F600
+G1 X68.114 Y17.888 F600
+G1 X68.072 Y17.934 F600
+G1 X67.971 Y18.074 F600
+G1 X67.904 Y18.234 F600
+G1 X67.875 Y18.441 F600
+G1 X67.875 Y29.112 F600
+G1 X67.895 Y29.284 F600
+G1 X67.954 Y29.446 F600
+G1 X68.072 Y29.619 F600
+G1 X68.114 Y29.665 F600
+G1 X68.139 Y29.702 F600
+G1 X68.160 Y29.753 F600
+G1 X68.165 Y29.777 F600
+G1 X68.168 Y29.805 F600
+G1 X68.169 Y29.829 F600
+G1 X68.164 Y29.872 F600
+G1 X68.157 Y29.899 F600
+G1 X68.142 Y29.935 F600
+G1 X68.114 Y29.978 F600
+G1 X68.096 Y29.998 F600
+G1 X68.076 Y30.017 F600
+G1 X68.045 Y30.037 F600
+G1 X68.017 Y30.051 F600
+G1 X67.997 Y30.059 F600
+G1 X67.976 Y30.065 F600
+G1 X67.936 Y30.070 F600
+G1 X67.920 Y30.071 F600
+G1 X67.869 Y30.066 F600
+G1 X67.842 Y30.059 F600
+G1 X67.804 Y30.043 F600
+G1 X67.759 Y30.014 F600
+G1 X67.716 Y29.974 F600
+G1 X67.576 Y29.872 F600
+G1 X67.417 Y29.805 F600
+G1 X67.209 Y29.776 F600
+G1 X56.540 Y29.776 F600
+G1 X56.368 Y29.796 F600
+G1 X56.206 Y29.855 F600
+G1 X56.033 Y29.974 F600
+G1 X55.990 Y30.014 F600
+G1 X55.945 Y30.043 F600
+G1 X55.907 Y30.059 F600
+G1 X55.880 Y30.066 F600
+G1 X55.829 Y30.071 F600
+G0 Z5.000
+G0 X55.829 Y49.121
+G1 Z-1.000 F200
+G1 X55.779 Y49.116 F600
+G1 X55.752 Y49.109 F600
+G1 X55.719 Y49.095 F600
+G1 X55.704 Y49.087 F600
+G1 X55.673 Y49.067 F600
+G1 X55.653 Y49.048 F600
+G1 X55.634 Y49.028 F600
+G1 X55.607 Y48.985 F600
+G1 X55.592 Y48.949 F600
+G1 X55.585 Y48.922 F600
+G1 X55.580 Y48.879 F600
+G1 X55.581 Y48.855 F600
+G1 X55.584 Y48.827 F600
+G1 X55.589 Y48.803 F600
+G1 X55.610 Y48.752 F600
+G1 X55.641 Y48.707 F600
+G1 X55.677 Y48.668 F600
+G1 X55.779 Y48.528 F600
+G1 X55.846 Y48.369 F600
+G1 X55.875 Y48.161 F600
+G1 X55.875 Y37.492 F600
+G1 X55.855 Y37.320 F600
+G1 X55.796 Y37.158 F600
+G1 X55.677 Y36.985 F600
+G1 X55.637 Y36.942 F600
+G1 X55.612 Y36.904 F600
+G1 X55.597 Y36.872 F600
+G1 X55.592 Y36.859 F600
+G1 X55.585 Y36.832 F600
+G1 X55.580 Y36.789 F600
+G1 X55.581 Y36.765 F600
+G1 X55.585 Y36.731 F600
+G1 X55.590 Y36.711 F600
+G1 X55.605 Y36.672 F600
+G1 X55.614 Y36.656 F600
+G1 X55.634 Y36.625 F600
+G1 X55.649 Y36.609 F600
+G1 X55.673 Y36.586 F600
+G1 X55.704 Y36.566 F600
+G1 X55.729 Y36.553 F600
+G1 X55.752 Y36.544 F600
+G1 X55.779 Y36.537 F600
+G1 X55.829 Y36.532 F600
+G1 X55.880 Y36.537 F600
+G1 X55.907 Y36.544 F600
+G1 X55.949 Y36.562 F600
+G1 X55.986 Y36.587 F600
+G1 X56.032 Y36.629 F600
+G1 X56.172 Y36.730 F600
+G1 X56.332 Y36.797 F600
+G1 X56.539 Y36.826 F600
+G1 X67.210 Y36.826 F600
+G1 X67.382 Y36.806 F600
+G1 X67.544 Y36.747 F600
+G1 X67.717 Y36.629 F600
+G1 X67.763 Y36.587 F600
+G1 X67.800 Y36.562 F600
+G1 X67.842 Y36.544 F600
+G1 X67.869 Y36.537 F600
+G1 X67.920 Y36.532 F600
+G1 X67.970 Y36.537 F600
+G1 X67.997 Y36.544 F600
+G1 X68.030 Y36.558 F600
+G1 X68.045 Y36.566 F600
+G1 X68.076 Y36.586 F600
+G1 X68.100 Y36.609 F600
+G1 X68.115 Y36.625 F600
+G1 X68.135 Y36.656 F600
+G1 X68.144 Y36.672 F600
+G1 X68.159 Y36.711 F600
+G1 X68.164 Y36.731 F600
+G1 X68.168 Y36.765 F600
+G1 X68.169 Y36.789 F600
+G1 X68.164 Y36.832 F600
+G1 X68.157 Y36.859 F600
+G1 X68.152 Y36.872 F600
+G1 X68.137 Y36.904 F600
+G1 X68.114 Y36.938 F600
+G1 X68.072 Y36.984 F600
+G1 X67.971 Y37.124 F600
+G1 X67.904 Y37.284 F600
+G1 X67.875 Y37.491 F600
+G1 X67.875 Y48.162 F600
+G1 X67.895 Y48.334 F600
+G1 X67.954 Y48.496 F600
+G1 X68.072 Y48.669 F600
+G1 X68.114 Y48.715 F600
+G1 X68.139 Y48.752 F600
+G1 X68.160 Y48.803 F600
+G1 X68.165 Y48.827 F600
+G1 X68.168 Y48.855 F600
+G1 X68.169 Y48.879 F600
+G1 X68.164 Y48.922 F600
+G1 X68.157 Y48.949 F600
+G1 X68.142 Y48.985 F600
+G1 X68.114 Y49.028 F600
+G1 X68.096 Y49.048 F600
+G1 X68.076 Y49.067 F600
+G1 X68.045 Y49.087 F600
+G1 X68.017 Y49.101 F600
+G1 X67.997 Y49.109 F600
+G1 X67.976 Y49.115 F600
+G1 X67.936 Y49.120 F600
+G1 X67.920 Y49.121 F600
+G1 X67.869 Y49.116 F600
+G1 X67.842 Y49.109 F600
+G1 X67.804 Y49.093 F600
+G1 X67.759 Y49.064 F600
+G1 X67.716 Y49.024 F600
+G1 X67.576 Y48.922 F600
+G1 X67.417 Y48.855 F600
+G1 X67.209 Y48.826 F600
+G1 X56.540 Y48.826 F600
+G1 X56.368 Y48.846 F600
+G1 X56.206 Y48.905 F600
+G1 X56.033 Y49.024 F600
+G1 X55.990 Y49.064 F600
+G1 X55.945 Y49.093 F600
+G1 X55.907 Y49.109 F600
+G1 X55.880 Y49.116 F600
+G1 X55.829 Y49.121 F600
+G1 Z-2.000 F200
+G1 X55.779 Y49.116 F600
+G1 X55.752 Y49.109 F600
+G1 X55.719 Y49.095 F600
+G1 X55.704 Y49.087 F600
+G1 X55.673 Y49.067 F600
+G1 X55.653 Y49.048 F600
+G1 X55.634 Y49.028 F600
+G1 X55.607 Y48.985 F600
+G1 X55.592 Y48.949 F600
+G1 X55.585 Y48.922 F600
+G1 X55.580 Y48.879 F600
+G1 X55.581 Y48.855 F600
+G1 X55.584 Y48.827 F600
+G1 X55.589 Y48.803 F600
+G1 X55.610 Y48.752 F600
+G1 X55.641 Y48.707 F600
+G1 X55.677 Y48.668 F600
+G1 X55.779 Y48.528 F600
+G1 X55.846 Y48.369 F600
+G1 X55.875 Y48.161 F600
+G1 X55.875 Y37.492 F600
+G1 X55.855 Y37.320 F600
+G1 X55.796 Y37.158 F600
+G1 X55.677 Y36.985 F600
+G1 X55.637 Y36.942 F600
+G1 X55.612 Y36.904 F600
+G1 X55.597 Y36.872 F600
+G1 X55.592 Y36.859 F600
+G1 X55.585 Y36.832 F600
+G1 X55.580 Y36.789 F600
+G1 X55.581 Y36.765 F600
+G1 X55.585 Y36.731 F600
+G1 X55.590 Y36.711 F600
+G1 X55.605 Y36.672 F600
+G1 X55.614 Y36.656 F600
+G1 X55.634 Y36.625 F600
+G1 X55.649 Y36.609 F600
+G1 X55.673 Y36.586 F600
+G1 X55.704 Y36.566 F600
+G1 X55.729 Y36.553 F600
+G1 X55.752 Y36.544 F600
+G1 X55.779 Y36.537 F600
+G1 X55.829 Y36.532 F600
+G1 X55.880 Y36.537 F600
+G1 X55.907 Y36.544 F600
+G1 X55.949 Y36.562 F600
+G1 X55.986 Y36.587 F600
+G1 X56.032 Y36.629 F600
+G1 X56.172 Y36.730 F600
+G1 X56.332 Y36.797 F600
+G1 X56.539 Y36.826 F600
+G1 X67.210 Y36.826 F600
+G1 X67.382 Y36.806 F600
+G1 X67.544 Y36.747 F600
+G1 X67.717 Y36.629 F600
+G1 X67.763 Y36.587 F600
+G1 X67.800 Y36.562 F600
+G1 X67.842 Y36.544 F600
+G1 X67.869 Y36.537 F600
+G1 X67.920 Y36.532 F600
+G1 X67.970 Y36.537 F600
+G1 X67.997 Y36.544 F600
+G1 X68.030 Y36.558 F600
+G1 X68.045 Y36.566 F600
+G1 X68.076 Y36.586 F600
+G1 X68.100 Y36.609 F600
+G1 X68.115 Y36.625 F600
+G1 X68.135 Y36.656 F600
+G1 X68.144 Y36.672 F600
+G1 X68.159 Y36.711 F600
+G1 X68.164 Y36.731 F600
+G1 X68.168 Y36.765 F600
+G1 X68.169 Y36.789 F600
+G1 X68.164 Y36.832 F600
+G1 X68.157 Y36.859 F600
+G1 X68.152 Y36.872 F600
+G1 X68.137 Y36.904 F600
+G1 X68.114 Y36.938 F600
+G1 X68.072 Y36.984 F600
+G1 X67.971 Y37.124 F600
+G1 X67.904 Y37.284 F600
+G1 X67.875 Y37.491 F600
+G1 X67.875 Y48.162 F600
+G1 X67.895 Y48.334 F600
+G1 X67.954 Y48.496 F600
+G1 X68.072 Y48.669 F600
+G1 X68.114 Y48.715 F600
+G1 X68.139 Y48.752 F600
+G1 X68.160 Y48.803 F600
+G1 X68.165 Y48.827 F600
+G1 X68.168 Y48.855 F600
+G1 X68.169 Y48.879 F600
+G1 X68.164 Y48.922 F600
+G1 X68.157 Y48.949 F600
+G1 X68.142 Y48.985 F600
+G1 X68.114 Y49.028 F600
+G1 X68.096 Y49.048 F600
+G1 X68.076 Y49.067 F600
+G1 X68.045 Y49.087 F600
+G1 X68.017 Y49.101 F600
+G1 X67.997 Y49.109 F600
+G1 X67.976 Y49.115 F600
+G1 X67.936 Y49.120 F600
+G1 X67.920 Y49.121 F600
+G1 X67.869 Y49.116 F600
+G1 X67.842 Y49.109 F600
+G1 X67.804 Y49.093 F600
+G1 X67.759 Y49.064 F600
+G1 X67.716 Y49.024 F600
+G1 X67.576 Y48.922 F600
+G1 X67.417 Y48.855 F600
+G1 X67.209 Y48.826 F600
+G1 X56.540 Y48.826 F600
+G1 X56.368 Y48.846 F600
+G1 X56.206 Y48.905 F600
+G1 X56.033 Y49.024 F600
+G1 X55.990 Y49.064 F600
+G1 X55.945 Y49.093 F600
+G1 X55.907 Y49.109 F600
+G1 X55.880 Y49.116 F600
+G1 X55.829 Y49.121 F600
+G1 Z-3.000 F200
+G1 X55.779 Y49.116 F600
+G1 X55.752 Y49.109 F600
+G1 X55.719 Y49.095 F600
+G1 X55.704 Y49.087 F600
+G1 X55.673 Y49.067 F600
+G1 X55.653 Y49.048 F600
+G1 X55.634 Y49.028 F600
+G1 X55.607 Y48.985 F600
+G1 X55.592 Y48.949 F600
+G1 X55.585 Y48.922 F600
+G1 X55.580 Y48.879 F600
+G1 X55.581 Y48.855 F600
+G1 X55.584 Y48.827 F600
+G1 X55.589 Y48.803 F600
+G1 X55.610 Y48.752 F600
+G1 X55.641 Y48.707 F600
+G1 X55.677 Y48.668 F600
+G1 X55.779 Y48.528 F600
+G1 X55.846 Y48.369 F600
+G1 X55.875 Y48.161 F600
+G1 X55.875 Y37.492 F600
+G1 X55.855 Y37.320 F600
+G1 X55.796 Y37.158 F600
+G1 X55.677 Y36.985 F600
+G1 X55.637 Y36.942 F600
+G1 X55.612 Y36.904 F600
+G1 X55.597 Y36.872 F600
+G1 X55.592 Y36.859 F600
+G1 X55.585 Y36.832 F600
+G1 X55.580 Y36.789 F600
+G1 X55.581 Y36.765 F600
+G1 X55.585 Y36.731 F600
+G1 X55.590 Y36.711 F600
+G1 X55.605 Y36.672 F600
+G1 X55.614 Y36.656 F600
+G1 X55.634 Y36.625 F600
+G1 X55.649 Y36.609 F600
+G1 X55.673 Y36.586 F600
+G1 X55.704 Y36.566 F600
+G1 X55.729 Y36.553 F600
+G1 X55.752 Y36.544 F600
+G1 X55.779 Y36.537 F600
+G1 X55.829 Y36.532 F600
+G1 X55.880 Y36.537 F600
+G1 X55.907 Y36.544 F600
+G1 X55.949 Y36.562 F600
+G1 X55.986 Y36.587 F600
+G1 X56.032 Y36.629 F600
+G1 X56.172 Y36.730 F600
+G1 X56.332 Y36.797 F600
+G1 X56.539 Y36.826 F600
+G1 X67.210 Y36.826 F600
+G1 X67.382 Y36.806 F600
+G1 X67.544 Y36.747 F600
+G1 X67.717 Y36.629 F600
+G1 X67.763 Y36.587 F600
+G1 X67.800 Y36.562 F600
+G1 X67.842 Y36.544 F600
+G1 X67.869 Y36.537 F600
+G1 X67.920 Y36.532 F600
+G1 X67.970 Y36.537 F600
+G1 X67.997 Y36.544 F600
+G1 X68.030 Y36.558 F600
+G1 X68.045 Y36.566 F600
+G1 X68.076 Y36.586 F600
+G1 X68.100 Y36.609 F600
+G1 X68.115 Y36.625 F600
+G1 X68.135 Y36.656 F600
+G1 X68.144 Y36.672 F600
+G1 X68.159 Y36.711 F600
+G1 X68.164 Y36.731 F600
+G1 X68.168 Y36.765 F600
+G1 X68.169 Y36.789 F600
+G1 X68.164 Y36.832 F600
+G1 X68.157 Y36.859 F600
+G1 X68.152 Y36.872 F600
+G1 X68.137 Y36.904 F600
+G1 X68.114 Y36.938 F600
+G1 X68.072 Y36.984 F600
+G1 X67.971 Y37.124 F600
+G1 X67.904 Y37.284 F600
+G1 X67.875 Y37.491 F600
+G1 X67.875 Y48.162 F600
+G1 X67.895 Y48.334 F600
+G1 X67.954 Y48.496 F600
+G1 X68.072 Y48.669 F600
+G1 X68.114 Y48.715 F600
+G1 X68.139 Y48.752 F600
+G1 X68.160 Y48.803 F600
+G1 X68.165 Y48.827 F600
+G1 X68.168 Y48.855 F600
+G1 X68.169 Y48.879 F600
+G1 X68.164 Y48.922 F600
+G1 X68.157 Y48.949 F600
+G1 X68.142 Y48.985 F600
+G1 X68.114 Y49.028 F600
+G1 X68.096 Y49.048 F600
+G1 X68.076 Y49.067 F600
+G1 X68.045 Y49.087 F600
+G1 X68.017 Y49.101 F600
+G1 X67.997 Y49.109 F600
+G1 X67.976 Y49.115 F600
+G1 X67.936 Y49.120 F600
+G1 X67.920 Y49.121 F600
+G1 X67.869 Y49.116 F600
+G1 X67.842 Y49.109 F600
+G1 X67.804 Y49.093 F600
+G1 X67.759 Y49.064 F600
+G1 X67.716 Y49.024 F600
+G1 X67.576 Y48.922 F600
+G1 X67.417 Y48.855 F600
+G1 X67.209 Y48.826 F600
+G1 X56.540 Y48.826 F600
+G1 X56.368 Y48.846 F600
+G1 X56.206 Y48.905 F600
+G1 X56.033 Y49.024 F600
+G1 X55.990 Y49.064 F600
+G1 X55.945 Y49.093 F600
+G1 X55.907 Y49.109 F600
+G1 X55.880 Y49.116 F600
+G1 X55.829 Y49.121 F600
+G0 Z5.000
+G0 X36.779 Y49.121
+G1 Z-1.000 F200
+G1 X36.735 Y49.117 F600
+G1 X36.723 Y49.115 F600
+G1 X36.702 Y49.109 F600
+G1 X36.669 Y49.095 F600
+G1 X36.654 Y49.087 F600
+G1 X36.623 Y49.067 F600
+G1 X36.603 Y49.048 F600
+G1 X36.584 Y49.028 F600
+G1 X36.557 Y48.985 F600
+G1 X36.542 Y48.949 F600
+G1 X36.535 Y48.922 F600
+G1 X36.530 Y48.879 F600
+G1 X36.531 Y48.855 F600
+G1 X36.534 Y48.827 F600
+G1 X36.539 Y48.803 F600
+G1 X36.560 Y48.752 F600
+G1 X36.591 Y48.707 F600
+G1 X36.627 Y48.668 F600
+G1 X36.729 Y48.528 F600
+G1 X36.796 Y48.369 F600
+G1 X36.825 Y48.161 F600
+G1 X36.825 Y37.492 F600
+G1 X36.805 Y37.320 F600
+G1 X36.746 Y37.158 F600
+G1 X36.627 Y36.985 F600
+G1 X36.587 Y36.942 F600
+G1 X36.562 Y36.904 F600
+G1 X36.547 Y36.872 F600
+G1 X36.542 Y36.859 F600
+G1 X36.535 Y36.832 F600
+G1 X36.530 Y36.789 F600
+G1 X36.531 Y36.765 F600
+G1 X36.535 Y36.731 F600
+G1 X36.540 Y36.711 F600
+G1 X36.555 Y36.672 F600
+G1 X36.564 Y36.656 F600
+G1 X36.584 Y36.625 F600
+G1 X36.599 Y36.609 F600
+G1 X36.623 Y36.586 F600
+G1 X36.654 Y36.566 F600
+G1 X36.679 Y36.553 F600
+G1 X36.702 Y36.544 F600
+G1 X36.729 Y36.537 F600
+G1 X36.779 Y36.532 F600
+G1 X36.830 Y36.537 F600
+G1 X36.857 Y36.544 F600
+G1 X36.899 Y36.562 F600
+G1 X36.936 Y36.587 F600
+G1 X36.982 Y36.629 F600
+G1 X37.122 Y36.730 F600
+G1 X37.282 Y36.797 F600
+G1 X37.489 Y36.826 F600
+G1 X48.160 Y36.826 F600
+G1 X48.332 Y36.806 F600
+G1 X48.494 Y36.747 F600
+G1 X48.667 Y36.629 F600
+G1 X48.713 Y36.587 F600
+G1 X48.750 Y36.562 F600
+G1 X48.792 Y36.544 F600
+G1 X48.819 Y36.537 F600
+G1 X48.870 Y36.532 F600
+G1 X48.920 Y36.537 F600
+G1 X48.947 Y36.544 F600
+G1 X48.980 Y36.558 F600
+G1 X48.995 Y36.566 F600
+G1 X49.026 Y36.586 F600
+G1 X49.050 Y36.609 F600
+G1 X49.065 Y36.625 F600
+G1 X49.085 Y36.656 F600
+G1 X49.094 Y36.672 F600
+G1 X49.109 Y36.711 F600
+G1 X49.114 Y36.731 F600
+G1 X49.118 Y36.765 F600
+G1 X49.119 Y36.789 F600
+G1 X49.114 Y36.832 F600
+G1 X49.107 Y36.859 F600
+G1 X49.102 Y36.872 F600
+G1 X49.087 Y36.904 F600
+G1 X49.064 Y36.938 F600
+G1 X49.022 Y36.984 F600
+G1 X48.921 Y37.124 F600
+G1 X48.854 Y37.284 F600
+G1 X48.825 Y37.491 F600
+G1 X48.825 Y48.162 F600
+G1 X48.845 Y48.334 F600
+G1 X48.904 Y48.496 F600
+G1 X49.022 Y48.669 F600
+G1 X49.064 Y48.715 F600
+G1 X49.089 Y48.752 F600
+G1 X49.110 Y48.803 F600
+G1 X49.115 Y48.827 F600
+G1 X49.118 Y48.855 F600
+G1 X49.119 Y48.879 F600
+G1 X49.114 Y48.922 F600
+G1 X49.107 Y48.949 F600
+G1 X49.092 Y48.985 F600
+G1 X49.065 Y49.028 F600
+G1 X49.046 Y49.048 F600
+G1 X49.026 Y49.067 F600
+G1 X48.995 Y49.087 F600
+G1 X48.967 Y49.101 F600
+G1 X48.947 Y49.109 F600
+G1 X48.920 Y49.116 F600
+G1 X48.870 Y49.121 F600
+G1 X48.825 Y49.117 F600
+G1 X48.813 Y49.115 F600
+G1 X48.792 Y49.109 F600
+G1 X48.754 Y49.093 F600
+G1 X48.709 Y49.064 F600
+G1 X48.666 Y49.024 F600
+G1 X48.526 Y48.922 F600
+G1 X48.367 Y48.855 F600
+G1 X48.159 Y48.826 F600
+G1 X37.490 Y48.826 F600
+G1 X37.318 Y48.846 F600
+G1 X37.156 Y48.905 F600
+G1 X36.983 Y49.024 F600
+G1 X36.940 Y49.064 F600
+G1 X36.895 Y49.093 F600
+G1 X36.857 Y49.109 F600
+G1 X36.830 Y49.116 F600
+G1 X36.779 Y49.121 F600
+G1 Z-2.000 F200
+G1 X36.735 Y49.117 F600
+G1 X36.723 Y49.115 F600
+G1 X36.702 Y49.109 F600
+G1 X36.669 Y49.095 F600
+G1 X36.654 Y49.087 F600
+G1 X36.623 Y49.067 F600
+G1 X36.603 Y49.048 F600
+G1 X36.584 Y49.028 F600
+G1 X36.557 Y48.985 F600
+G1 X36.542 Y48.949 F600
+G1 X36.535 Y48.922 F600
+G1 X36.530 Y48.879 F600
+G1 X36.531 Y48.855 F600
+G1 X36.534 Y48.827 F600
+G1 X36.539 Y48.803 F600
+G1 X36.560 Y48.752 F600
+G1 X36.591 Y48.707 F600
+G1 X36.627 Y48.668 F600
+G1 X36.729 Y48.528 F600
+G1 X36.796 Y48.369 F600
+G1 X36.825 Y48.161 F600
+G1 X36.825 Y37.492 F600
+G1 X36.805 Y37.320 F600
+G1 X36.746 Y37.158 F600
+G1 X36.627 Y36.985 F600
+G1 X36.587 Y36.942 F600
+G1 X36.562 Y36.904 F600
+G1 X36.547 Y36.872 F600
+G1 X36.542 Y36.859 F600
+G1 X36.535 Y36.832 F600
+G1 X36.530 Y36.789 F600
+G1 X36.531 Y36.765 F600
+G1 X36.535 Y36.731 F600
+G1 X36.540 Y36.711 F600
+G1 X36.555 Y36.672 F600
+G1 X36.564 Y36.656 F600
+G1 X36.584 Y36.625 F600
+G1 X36.599 Y36.609 F600
+G1 X36.623 Y36.586 F600
+G1 X36.654 Y36.566 F600
+G1 X36.679 Y36.553 F600
+G1 X36.702 Y36.544 F600
+G1 X36.729 Y36.537 F600
+G1 X36.779 Y36.532 F600
+G1 X36.830 Y36.537 F600
+G1 X36.857 Y36.544 F600
+G1 X36.899 Y36.562 F600
+G1 X36.936 Y36.587 F600
+G1 X36.982 Y36.629 F600
+G1 X37.122 Y36.730 F600
+G1 X37.282 Y36.797 F600
+G1 X37.489 Y36.826 F600
+G1 X48.160 Y36.826 F600
+G1 X48.332 Y36.806 F600
+G1 X48.494 Y36.747 F600
+G1 X48.667 Y36.629 F600
+G1 X48.713 Y36.587 F600
+G1 X48.750 Y36.562 F600
+G1 X48.792 Y36.544 F600
+G1 X48.819 Y36.537 F600
+G1 X48.870 Y36.532 F600
+G1 X48.920 Y36.537 F600
+G1 X48.947 Y36.544 F600
+G1 X48.980 Y36.558 F600
+G1 X48.995 Y36.566 F600
+G1 X49.026 Y36.586 F600
+G1 X49.050 Y36.609 F600
+G1 X49.065 Y36.625 F600
+G1 X49.085 Y36.656 F600
+G1 X49.094 Y36.672 F600
+G1 X49.109 Y36.711 F600
+G1 X49.114 Y36.731 F600
+G1 X49.118 Y36.765 F600
+G1 X49.119 Y36.789 F600
+G1 X49.114 Y36.832 F600
+G1 X49.107 Y36.859 F600
+G1 X49.102 Y36.872 F600
+G1 X49.087 Y36.904 F600
+G1 X49.064 Y36.938 F600
+G1 X49.022 Y36.984 F600
+G1 X48.921 Y37.124 F600
+G1 X48.854 Y37.284 F600
+G1 X48.825 Y37.491 F600
+G1 X48.825 Y48.162 F600
+G1 X48.845 Y48.334 F600
+G1 X48.904 Y48.496 F600
+G1 X49.022 Y48.669 F600
+G1 X49.064 Y48.715 F600
+G1 X49.089 Y48.752 F600
+G1 X49.110 Y48.803 F600
+G1 X49.115 Y48.827 F600
+G1 X49.118 Y48.855 F600
+G1 X49.119 Y48.879 F600
+G1 X49.114 Y48.922 F600
+G1 X49.107 Y48.949 F600
+G1 X49.092 Y48.985 F600
+G1 X49.065 Y49.028 F600
+G1 X49.046 Y49.048 F600
+G1 X49.026 Y49.067 F600
+G1 X48.995 Y49.087 F600
+G1 X48.967 Y49.101 F600
+G1 X48.947 Y49.109 F600
+G1 X48.920 Y49.116 F600
+G1 X48.870 Y49.121 F600
+G1 X48.825 Y49.117 F600
+G1 X48.813 Y49.115 F600
+G1 X48.792 Y49.109 F600
+G1 X48.754 Y49.093 F600
+G1 X48.709 Y49.064 F600
+G1 X48.666 Y49.024 F600
+G1 X48.526 Y48.922 F600
+G1 X48.367 Y48.855 F600
+G1 X48.159 Y48.826 F600
+G1 X37.490 Y48.826 F600
+G1 X37.318 Y48.846 F600
+G1 X37.156 Y48.905 F600
+G1 X36.983 Y49.024 F600
+G1 X36.940 Y49.064 F600
+G1 X36.895 Y49.093 F600
+G1 X36.857 Y49.109 F600
+G1 X36.830 Y49.116 F600
+G1 X36.779 Y49.121 F600
+G1 Z-3.000 F200
+G1 X36.735 Y49.117 F600
+G1 X36.723 Y49.115 F600
+G1 X36.702 Y49.109 F600
+G1 X36.669 Y49.095 F600
+G1 X36.654 Y49.087 F600
+G1 X36.623 Y49.067 F600
+G1 X36.603 Y49.048 F600
+G1 X36.584 Y49.028 F600
+G1 X36.557 Y48.985 F600
+G1 X36.542 Y48.949 F600
+G1 X36.535 Y48.922 F600
+G1 X36.530 Y48.879 F600
+G1 X36.531 Y48.855 F600
+G1 X36.534 Y48.827 F600
+G1 X36.539 Y48.803 F600
+G1 X36.560 Y48.752 F600
+G1 X36.591 Y48.707 F600
+G1 X36.627 Y48.668 F600
+G1 X36.729 Y48.528 F600
+G1 X36.796 Y48.369 F600
+G1 X36.825 Y48.161 F600
+G1 X36.825 Y37.492 F600
+G1 X36.805 Y37.320 F600
+G1 X36.746 Y37.158 F600
+G1 X36.627 Y36.985 F600
+G1 X36.587 Y36.942 F600
+G1 X36.562 Y36.904 F600
+G1 X36.547 Y36.872 F600
+G1 X36.542 Y36.859 F600
+G1 X36.535 Y36.832 F600
+G1 X36.530 Y36.789 F600
+G1 X36.531 Y36.765 F600
+G1 X36.535 Y36.731 F600
+G1 X36.540 Y36.711 F600
+G1 X36.555 Y36.672 F600
+G1 X36.564 Y36.656 F600
+G1 X36.584 Y36.625 F600
+G1 X36.599 Y36.609 F600
+G1 X36.623 Y36.586 F600
+G1 X36.654 Y36.566 F600
+G1 X36.679 Y36.553 F600
+G1 X36.702 Y36.544 F600
+G1 X36.729 Y36.537 F600
+G1 X36.779 Y36.532 F600
+G1 X36.830 Y36.537 F600
+G1 X36.857 Y36.544 F600
+G1 X36.899 Y36.562 F600
+G1 X36.936 Y36.587 F600
+G1 X36.982 Y36.629 F600
+G1 X37.122 Y36.730 F600
+G1 X37.282 Y36.797 F600
+G1 X37.489 Y36.826 F600
+G1 X48.160 Y36.826 F600
+G1 X48.332 Y36.806 F600
+G1 X48.494 Y36.747 F600
+G1 X48.667 Y36.629 F600
+G1 X48.713 Y36.587 F600
+G1 X48.750 Y36.562 F600
+G1 X48.792 Y36.544 F600
+G1 X48.819 Y36.537 F600
+G1 X48.870 Y36.532 F600
+G1 X48.920 Y36.537 F600
+G1 X48.947 Y36.544 F600
+G1 X48.980 Y36.558 F600
+G1 X48.995 Y36.566 F600
+G1 X49.026 Y36.586 F600
+G1 X49.050 Y36.609 F600
+G1 X49.065 Y36.625 F600
+G1 X49.085 Y36.656 F600
+G1 X49.094 Y36.672 F600
+G1 X49.109 Y36.711 F600
+G1 X49.114 Y36.731 F600
+G1 X49.118 Y36.765 F600
+G1 X49.119 Y36.789 F600
+G1 X49.114 Y36.832 F600
+G1 X49.107 Y36.859 F600
+G1 X49.102 Y36.872 F600
+G1 X49.087 Y36.904 F600
+G1 X49.064 Y36.938 F600
+G1 X49.022 Y36.984 F600
+G1 X48.921 Y37.124 F600
+G1 X48.854 Y37.284 F600
+G1 X48.825 Y37.491 F600
+G1 X48.825 Y48.162 F600
+G1 X48.845 Y48.334 F600
+G1 X48.904 Y48.496 F600
+G1 X49.022 Y48.669 F600
+G1 X49.064 Y48.715 F600
+G1 X49.089 Y48.752 F600
+G1 X49.110 Y48.803 F600
+G1 X49.115 Y48.827 F600
+G1 X49.118 Y48.855 F600
+G1 X49.119 Y48.879 F600
+G1 X49.114 Y48.922 F600
+G1 X49.107 Y48.949 F600
+G1 X49.092 Y48.985 F600
+G1 X49.065 Y49.028 F600
+G1 X49.046 Y49.048 F600
+G1 X49.026 Y49.067 F600
+G1 X48.995 Y49.087 F600
+G1 X48.967 Y49.101 F600
+G1 X48.947 Y49.109 F600
+G1 X48.920 Y49.116 F600
+G1 X48.870 Y49.121 F600
+G1 X48.825 Y49.117 F600
+G1 X48.813 Y49.115 F600
+G1 X48.792 Y49.109 F600
+G1 X48.754 Y49.093 F600
+G1 X48.709 Y49.064 F600
+G1 X48.666 Y49.024 F600
+G1 X48.526 Y48.922 F600
+G1 X48.367 Y48.855 F600
+G1 X48.159 Y48.826 F600
+G1 X37.490 Y48.826 F600
+G1 X37.318 Y48.846 F600
+G1 X37.156 Y48.905 F600
+G1 X36.983 Y49.024 F600
+G1 X36.940 Y49.064 F600
+G1 X36.895 Y49.093 F600
+G1 X36.857 Y49.109 F600
+G1 X36.830 Y49.116 F600
+G1 X36.779 Y49.121 F600
+G0 Z5.000
+G0 X80.823 Y62.331
+G1 Z-1.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-2.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-3.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 Z-2.000 F200
+G1 X81.401 Y26.953 F600
+G1 Z-3.000 F200
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 Z-2.000 F200
+G1 X36.478 Y4.250 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 Z-2.000 F200
+G1 X4.250 Y39.648 F600
+G1 Z-3.000 F200
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 Z-2.000 F200
+G1 X49.173 Y62.351 F600
+G1 Z-3.000 F200
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
@@ -0,0 +1,516 @@
+(gcode_cnc_kerf top)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X15.202 Y51.648
+G1 Z-1.000 F200
+G1 X15.158 Y51.644 F600
+G1 X15.146 Y51.642 F600
+G1 X15.125 Y51.636 F600
+G1 X15.092 Y51.622 F600
+G1 X15.077 Y51.614 F600
+G1 X15.046 Y51.594 F600
+G1 X15.026 Y51.575 F600
+G1 X15.007 Y51.555 F600
+G1 X14.980 Y51.512 F600
+G1 X14.965 Y51.476 F600
+G1 X14.958 Y51.449 F600
+G1 X14.953 Y51.406 F600
+G1 X14.954 Y51.382 F600
+G1 X14.957 Y51.354 F600
+G1 X14.962 Y51.330 F600
+G1 X14.983 Y51.279 F600
+G1 X15.014 Y51.234 F600
+G1 X15.050 Y51.195 F600
+G1 X15.152 Y51.055 F600
+G1 X15.219 Y50.896 F600
+G1 X15.248 Y50.688 F600
+G1 X15.248 Y15.916 F600
+G1 X15.228 Y15.744 F600
+G1 X15.169 Y15.582 F600
+G1 X15.050 Y15.409 F600
+G1 X15.010 Y15.366 F600
+G1 X14.985 Y15.328 F600
+G1 X14.970 Y15.296 F600
+G1 X14.965 Y15.283 F600
+G1 X14.958 Y15.256 F600
+G1 X14.953 Y15.213 F600
+G1 X14.954 Y15.189 F600
+G1 X14.958 Y15.155 F600
+G1 X14.963 Y15.135 F600
+G1 X14.978 Y15.096 F600
+G1 X14.987 Y15.080 F600
+G1 X15.007 Y15.049 F600
+G1 X15.022 Y15.033 F600
+G1 X15.046 Y15.010 F600
+G1 X15.077 Y14.990 F600
+G1 X15.102 Y14.977 F600
+G1 X15.125 Y14.968 F600
+G1 X15.152 Y14.961 F600
+G1 X15.202 Y14.956 F600
+G1 X15.253 Y14.961 F600
+G1 X15.280 Y14.968 F600
+G1 X15.322 Y14.986 F600
+G1 X15.359 Y15.011 F600
+G1 X15.405 Y15.053 F600
+G1 X15.545 Y15.154 F600
+G1 X15.705 Y15.221 F600
+G1 X15.912 Y15.250 F600
+G1 X69.736 Y15.250 F600
+G1 X69.908 Y15.230 F600
+G1 X70.070 Y15.171 F600
+G1 X70.243 Y15.053 F600
+G1 X70.289 Y15.011 F600
+G1 X70.326 Y14.986 F600
+G1 X70.368 Y14.968 F600
+G1 X70.395 Y14.961 F600
+G1 X70.446 Y14.956 F600
+G1 X70.496 Y14.961 F600
+G1 X70.523 Y14.968 F600
+G1 X70.556 Y14.982 F600
+G1 X70.571 Y14.990 F600
+G1 X70.602 Y15.010 F600
+G1 X70.626 Y15.033 F600
+G1 X70.641 Y15.049 F600
+G1 X70.661 Y15.080 F600
+G1 X70.670 Y15.096 F600
+G1 X70.685 Y15.135 F600
+G1 X70.690 Y15.155 F600
+G1 X70.694 Y15.189 F600
+G1 X70.695 Y15.213 F600
+G1 X70.690 Y15.256 F600
+G1 X70.683 Y15.283 F600
+G1 X70.678 Y15.296 F600
+G1 X70.663 Y15.328 F600
+G1 X70.640 Y15.362 F600
+G1 X70.598 Y15.408 F600
+G1 X70.497 Y15.548 F600
+G1 X70.430 Y15.708 F600
+G1 X70.401 Y15.915 F600
+G1 X70.401 Y50.689 F600
+G1 X70.421 Y50.861 F600
+G1 X70.480 Y51.023 F600
+G1 X70.598 Y51.196 F600
+G1 X70.640 Y51.242 F600
+G1 X70.665 Y51.279 F600
+G1 X70.686 Y51.330 F600
+G1 X70.691 Y51.354 F600
+G1 X70.694 Y51.382 F600
+G1 X70.695 Y51.406 F600
+G1 X70.690 Y51.449 F600
+G1 X70.683 Y51.476 F600
+G1 X70.668 Y51.512 F600
+G1 X70.640 Y51.555 F600
+G1 X70.622 Y51.575 F600
+G1 X70.602 Y51.594 F600
+G1 X70.571 Y51.614 F600
+G1 X70.543 Y51.628 F600
+G1 X70.523 Y51.636 F600
+G1 X70.502 Y51.642 F600
+G1 X70.462 Y51.647 F600
+G1 X70.446 Y51.648 F600
+G1 X70.401 Y51.644 F600
+G1 X70.389 Y51.642 F600
+G1 X70.368 Y51.636 F600
+G1 X70.330 Y51.620 F600
+G1 X70.285 Y51.591 F600
+G1 X70.242 Y51.551 F600
+G1 X70.102 Y51.449 F600
+G1 X69.943 Y51.382 F600
+G1 X69.735 Y51.353 F600
+G1 X15.913 Y51.353 F600
+G1 X15.741 Y51.373 F600
+G1 X15.579 Y51.432 F600
+G1 X15.406 Y51.551 F600
+G1 X15.363 Y51.591 F600
+G1 X15.318 Y51.620 F600
+G1 X15.280 Y51.636 F600
+G1 X15.253 Y51.643 F600
+G1 X15.202 Y51.648 F600
+G1 Z-2.000 F200
+G1 X15.158 Y51.644 F600
+G1 X15.146 Y51.642 F600
+G1 X15.125 Y51.636 F600
+G1 X15.092 Y51.622 F600
+G1 X15.077 Y51.614 F600
+G1 X15.046 Y51.594 F600
+G1 X15.026 Y51.575 F600
+G1 X15.007 Y51.555 F600
+G1 X14.980 Y51.512 F600
+G1 X14.965 Y51.476 F600
+G1 X14.958 Y51.449 F600
+G1 X14.953 Y51.406 F600
+G1 X14.954 Y51.382 F600
+G1 X14.957 Y51.354 F600
+G1 X14.962 Y51.330 F600
+G1 X14.983 Y51.279 F600
+G1 X15.014 Y51.234 F600
+G1 X15.050 Y51.195 F600
+G1 X15.152 Y51.055 F600
+G1 X15.219 Y50.896 F600
+G1 X15.248 Y50.688 F600
+G1 X15.248 Y15.916 F600
+G1 X15.228 Y15.744 F600
+G1 X15.169 Y15.582 F600
+G1 X15.050 Y15.409 F600
+G1 X15.010 Y15.366 F600
+G1 X14.985 Y15.328 F600
+G1 X14.970 Y15.296 F600
+G1 X14.965 Y15.283 F600
+G1 X14.958 Y15.256 F600
+G1 X14.953 Y15.213 F600
+G1 X14.954 Y15.189 F600
+G1 X14.958 Y15.155 F600
+G1 X14.963 Y15.135 F600
+G1 X14.978 Y15.096 F600
+G1 X14.987 Y15.080 F600
+G1 X15.007 Y15.049 F600
+G1 X15.022 Y15.033 F600
+G1 X15.046 Y15.010 F600
+G1 X15.077 Y14.990 F600
+G1 X15.102 Y14.977 F600
+G1 X15.125 Y14.968 F600
+G1 X15.152 Y14.961 F600
+G1 X15.202 Y14.956 F600
+G1 X15.253 Y14.961 F600
+G1 X15.280 Y14.968 F600
+G1 X15.322 Y14.986 F600
+G1 X15.359 Y15.011 F600
+G1 X15.405 Y15.053 F600
+G1 X15.545 Y15.154 F600
+G1 X15.705 Y15.221 F600
+G1 X15.912 Y15.250 F600
+G1 X69.736 Y15.250 F600
+G1 X69.908 Y15.230 F600
+G1 X70.070 Y15.171 F600
+G1 X70.243 Y15.053 F600
+G1 X70.289 Y15.011 F600
+G1 X70.326 Y14.986 F600
+G1 X70.368 Y14.968 F600
+G1 X70.395 Y14.961 F600
+G1 X70.446 Y14.956 F600
+G1 X70.496 Y14.961 F600
+G1 X70.523 Y14.968 F600
+G1 X70.556 Y14.982 F600
+G1 X70.571 Y14.990 F600
+G1 X70.602 Y15.010 F600
+G1 X70.626 Y15.033 F600
+G1 X70.641 Y15.049 F600
+G1 X70.661 Y15.080 F600
+G1 X70.670 Y15.096 F600
+G1 X70.685 Y15.135 F600
+G1 X70.690 Y15.155 F600
+G1 X70.694 Y15.189 F600
+G1 X70.695 Y15.213 F600
+G1 X70.690 Y15.256 F600
+G1 X70.683 Y15.283 F600
+G1 X70.678 Y15.296 F600
+G1 X70.663 Y15.328 F600
+G1 X70.640 Y15.362 F600
+G1 X70.598 Y15.408 F600
+G1 X70.497 Y15.548 F600
+G1 X70.430 Y15.708 F600
+G1 X70.401 Y15.915 F600
+G1 X70.401 Y50.689 F600
+G1 X70.421 Y50.861 F600
+G1 X70.480 Y51.023 F600
+G1 X70.598 Y51.196 F600
+G1 X70.640 Y51.242 F600
+G1 X70.665 Y51.279 F600
+G1 X70.686 Y51.330 F600
+G1 X70.691 Y51.354 F600
+G1 X70.694 Y51.382 F600
+G1 X70.695 Y51.406 F600
+G1 X70.690 Y51.449 F600
+G1 X70.683 Y51.476 F600
+G1 X70.668 Y51.512 F600
+G1 X70.640 Y51.555 F600
+G1 X70.622 Y51.575 F600
+G1 X70.602 Y51.594 F600
+G1 X70.571 Y51.614 F600
+G1 X70.543 Y51.628 F600
+G1 X70.523 Y51.636 F600
+G1 X70.502 Y51.642 F600
+G1 X70.462 Y51.647 F600
+G1 X70.446 Y51.648 F600
+G1 X70.401 Y51.644 F600
+G1 X70.389 Y51.642 F600
+G1 X70.368 Y51.636 F600
+G1 X70.330 Y51.620 F600
+G1 X70.285 Y51.591 F600
+G1 X70.242 Y51.551 F600
+G1 X70.102 Y51.449 F600
+G1 X69.943 Y51.382 F600
+G1 X69.735 Y51.353 F600
+G1 X15.913 Y51.353 F600
+G1 X15.741 Y51.373 F600
+G1 X15.579 Y51.432 F600
+G1 X15.406 Y51.551 F600
+G1 X15.363 Y51.591 F600
+G1 X15.318 Y51.620 F600
+G1 X15.280 Y51.636 F600
+G1 X15.253 Y51.643 F600
+G1 X15.202 Y51.648 F600
+G1 Z-3.000 F200
+G1 X15.158 Y51.644 F600
+G1 X15.146 Y51.642 F600
+G1 X15.125 Y51.636 F600
+G1 X15.092 Y51.622 F600
+G1 X15.077 Y51.614 F600
+G1 X15.046 Y51.594 F600
+G1 X15.026 Y51.575 F600
+G1 X15.007 Y51.555 F600
+G1 X14.980 Y51.512 F600
+G1 X14.965 Y51.476 F600
+G1 X14.958 Y51.449 F600
+G1 X14.953 Y51.406 F600
+G1 X14.954 Y51.382 F600
+G1 X14.957 Y51.354 F600
+G1 X14.962 Y51.330 F600
+G1 X14.983 Y51.279 F600
+G1 X15.014 Y51.234 F600
+G1 X15.050 Y51.195 F600
+G1 X15.152 Y51.055 F600
+G1 X15.219 Y50.896 F600
+G1 X15.248 Y50.688 F600
+G1 X15.248 Y15.916 F600
+G1 X15.228 Y15.744 F600
+G1 X15.169 Y15.582 F600
+G1 X15.050 Y15.409 F600
+G1 X15.010 Y15.366 F600
+G1 X14.985 Y15.328 F600
+G1 X14.970 Y15.296 F600
+G1 X14.965 Y15.283 F600
+G1 X14.958 Y15.256 F600
+G1 X14.953 Y15.213 F600
+G1 X14.954 Y15.189 F600
+G1 X14.958 Y15.155 F600
+G1 X14.963 Y15.135 F600
+G1 X14.978 Y15.096 F600
+G1 X14.987 Y15.080 F600
+G1 X15.007 Y15.049 F600
+G1 X15.022 Y15.033 F600
+G1 X15.046 Y15.010 F600
+G1 X15.077 Y14.990 F600
+G1 X15.102 Y14.977 F600
+G1 X15.125 Y14.968 F600
+G1 X15.152 Y14.961 F600
+G1 X15.202 Y14.956 F600
+G1 X15.253 Y14.961 F600
+G1 X15.280 Y14.968 F600
+G1 X15.322 Y14.986 F600
+G1 X15.359 Y15.011 F600
+G1 X15.405 Y15.053 F600
+G1 X15.545 Y15.154 F600
+G1 X15.705 Y15.221 F600
+G1 X15.912 Y15.250 F600
+G1 X69.736 Y15.250 F600
+G1 X69.908 Y15.230 F600
+G1 X70.070 Y15.171 F600
+G1 X70.243 Y15.053 F600
+G1 X70.289 Y15.011 F600
+G1 X70.326 Y14.986 F600
+G1 X70.368 Y14.968 F600
+G1 X70.395 Y14.961 F600
+G1 X70.446 Y14.956 F600
+G1 X70.496 Y14.961 F600
+G1 X70.523 Y14.968 F600
+G1 X70.556 Y14.982 F600
+G1 X70.571 Y14.990 F600
+G1 X70.602 Y15.010 F600
+G1 X70.626 Y15.033 F600
+G1 X70.641 Y15.049 F600
+G1 X70.661 Y15.080 F600
+G1 X70.670 Y15.096 F600
+G1 X70.685 Y15.135 F600
+G1 X70.690 Y15.155 F600
+G1 X70.694 Y15.189 F600
+G1 X70.695 Y15.213 F600
+G1 X70.690 Y15.256 F600
+G1 X70.683 Y15.283 F600
+G1 X70.678 Y15.296 F600
+G1 X70.663 Y15.328 F600
+G1 X70.640 Y15.362 F600
+G1 X70.598 Y15.408 F600
+G1 X70.497 Y15.548 F600
+G1 X70.430 Y15.708 F600
+G1 X70.401 Y15.915 F600
+G1 X70.401 Y50.689 F600
+G1 X70.421 Y50.861 F600
+G1 X70.480 Y51.023 F600
+G1 X70.598 Y51.196 F600
+G1 X70.640 Y51.242 F600
+G1 X70.665 Y51.279 F600
+G1 X70.686 Y51.330 F600
+G1 X70.691 Y51.354 F600
+G1 X70.694 Y51.382 F600
+G1 X70.695 Y51.406 F600
+G1 X70.690 Y51.449 F600
+G1 X70.683 Y51.476 F600
+G1 X70.668 Y51.512 F600
+G1 X70.640 Y51.555 F600
+G1 X70.622 Y51.575 F600
+G1 X70.602 Y51.594 F600
+G1 X70.571 Y51.614 F600
+G1 X70.543 Y51.628 F600
+G1 X70.523 Y51.636 F600
+G1 X70.502 Y51.642 F600
+G1 X70.462 Y51.647 F600
+G1 X70.446 Y51.648 F600
+G1 X70.401 Y51.644 F600
+G1 X70.389 Y51.642 F600
+G1 X70.368 Y51.636 F600
+G1 X70.330 Y51.620 F600
+G1 X70.285 Y51.591 F600
+G1 X70.242 Y51.551 F600
+G1 X70.102 Y51.449 F600
+G1 X69.943 Y51.382 F600
+G1 X69.735 Y51.353 F600
+G1 X15.913 Y51.353 F600
+G1 X15.741 Y51.373 F600
+G1 X15.579 Y51.432 F600
+G1 X15.406 Y51.551 F600
+G1 X15.363 Y51.591 F600
+G1 X15.318 Y51.620 F600
+G1 X15.280 Y51.636 F600
+G1 X15.253 Y51.643 F600
+G1 X15.202 Y51.648 F600
+G0 Z5.000
+G0 X80.823 Y62.331
+G1 Z-1.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-2.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 X81.401 Y26.953 F600
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 X36.478 Y4.250 F600
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 X4.250 Y39.648 F600
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 X49.173 Y62.351 F600
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G1 Z-3.000 F200
+G1 X80.985 Y62.272 F600
+G1 X81.130 Y62.178 F600
+G1 X81.250 Y62.053 F600
+G1 X81.337 Y61.904 F600
+G1 X81.388 Y61.739 F600
+G1 X81.401 Y61.601 F600
+G1 X81.401 Y30.953 F600
+G1 Z-2.000 F200
+G1 X81.401 Y26.953 F600
+G1 Z-3.000 F200
+G1 X81.401 Y5.000 F600
+G1 X81.381 Y4.828 F600
+G1 X81.322 Y4.666 F600
+G1 X81.228 Y4.521 F600
+G1 X81.103 Y4.401 F600
+G1 X80.954 Y4.314 F600
+G1 X80.789 Y4.263 F600
+G1 X80.651 Y4.250 F600
+G1 X40.478 Y4.250 F600
+G1 Z-2.000 F200
+G1 X36.478 Y4.250 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.250 F600
+G1 X4.828 Y4.270 F600
+G1 X4.666 Y4.329 F600
+G1 X4.521 Y4.423 F600
+G1 X4.401 Y4.548 F600
+G1 X4.314 Y4.697 F600
+G1 X4.263 Y4.862 F600
+G1 X4.250 Y5.000 F600
+G1 X4.250 Y35.648 F600
+G1 Z-2.000 F200
+G1 X4.250 Y39.648 F600
+G1 Z-3.000 F200
+G1 X4.250 Y61.601 F600
+G1 X4.270 Y61.773 F600
+G1 X4.329 Y61.935 F600
+G1 X4.423 Y62.080 F600
+G1 X4.548 Y62.200 F600
+G1 X4.697 Y62.287 F600
+G1 X4.862 Y62.338 F600
+G1 X5.000 Y62.351 F600
+G1 X45.173 Y62.351 F600
+G1 Z-2.000 F200
+G1 X49.173 Y62.351 F600
+G1 Z-3.000 F200
+G1 X80.651 Y62.351 F600
+G1 X80.823 Y62.331 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
@@ -0,0 +1,394 @@
+(gcode_cnc open)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X37.774 Y62.081
+G1 Z-1.000 F200
+G1 X37.965 Y62.022 F600
+G1 X38.140 Y61.926 F600
+G1 X38.293 Y61.797 F600
+G1 X38.417 Y61.641 F600
+G1 X38.507 Y61.463 F600
+G1 X38.561 Y61.270 F600
+G1 X38.575 Y61.101 F600
+G1 X38.575 Y52.101 F600
+G1 X38.555 Y51.902 F600
+G1 X38.496 Y51.711 F600
+G1 X38.400 Y51.536 F600
+G1 X38.271 Y51.383 F600
+G1 X38.115 Y51.259 F600
+G1 X37.937 Y51.169 F600
+G1 X37.744 Y51.115 F600
+G1 X37.575 Y51.101 F600
+G1 X15.415 Y51.101 F600
+G1 X15.216 Y51.121 F600
+G1 X15.025 Y51.180 F600
+G1 X14.850 Y51.276 F600
+G1 X14.739 Y51.364 F600
+G1 X14.710 Y51.391 F600
+G1 X14.737 Y51.362 F600
+G1 X14.856 Y51.202 F600
+G1 X14.942 Y51.022 F600
+G1 X14.990 Y50.828 F600
+G1 X15.000 Y50.686 F600
+G1 X15.000 Y31.041 F600
+G1 X15.000 Y27.041 F600
+G1 X15.000 Y15.416 F600
+G1 X14.980 Y15.217 F600
+G1 X14.921 Y15.026 F600
+G1 X14.825 Y14.851 F600
+G1 X14.737 Y14.740 F600
+G1 X14.719 Y14.720 F600
+G1 X14.738 Y14.737 F600
+G1 X14.898 Y14.857 F600
+G1 X15.079 Y14.942 F600
+G1 X15.273 Y14.990 F600
+G1 X15.414 Y15.000 F600
+G1 X69.736 Y15.000 F600
+G1 X69.935 Y14.980 F600
+G1 X70.126 Y14.921 F600
+G1 X70.301 Y14.825 F600
+G1 X70.412 Y14.737 F600
+G1 X70.431 Y14.720 F600
+G1 X70.414 Y14.739 F600
+G1 X70.294 Y14.899 F600
+G1 X70.209 Y15.080 F600
+G1 X70.161 Y15.274 F600
+G1 X70.151 Y15.415 F600
+G1 X70.151 Y50.687 F600
+G1 X70.171 Y50.886 F600
+G1 X70.230 Y51.077 F600
+G1 X70.326 Y51.252 F600
+G1 X70.414 Y51.363 F600
+G1 X70.440 Y51.391 F600
+G1 X70.411 Y51.364 F600
+G1 X70.251 Y51.245 F600
+G1 X70.071 Y51.159 F600
+G1 X69.877 Y51.111 F600
+G1 X69.735 Y51.101 F600
+G1 X64.976 Y51.101 F600
+G1 X60.976 Y51.101 F600
+G1 X47.575 Y51.101 F600
+G1 X47.376 Y51.121 F600
+G1 X47.185 Y51.180 F600
+G1 X47.010 Y51.276 F600
+G1 X46.857 Y51.405 F600
+G1 X46.733 Y51.561 F600
+G1 X46.643 Y51.739 F600
+G1 X46.589 Y51.932 F600
+G1 X46.575 Y52.101 F600
+G1 X46.575 Y61.101 F600
+G1 X46.595 Y61.300 F600
+G1 X46.654 Y61.491 F600
+G1 X46.750 Y61.666 F600
+G1 X46.879 Y61.819 F600
+G1 X47.035 Y61.943 F600
+G1 X47.213 Y62.033 F600
+G1 X47.406 Y62.087 F600
+G1 X47.575 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y10.177 F600
+G1 X81.151 Y6.177 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y36.141 F600
+G1 X4.000 Y40.141 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X37.575 Y62.101 F600
+G1 X37.774 Y62.081 F600
+G1 Z-2.000 F200
+G1 X37.965 Y62.022 F600
+G1 X38.140 Y61.926 F600
+G1 X38.293 Y61.797 F600
+G1 X38.417 Y61.641 F600
+G1 X38.507 Y61.463 F600
+G1 X38.561 Y61.270 F600
+G1 X38.575 Y61.101 F600
+G1 X38.575 Y52.101 F600
+G1 X38.555 Y51.902 F600
+G1 X38.496 Y51.711 F600
+G1 X38.400 Y51.536 F600
+G1 X38.271 Y51.383 F600
+G1 X38.115 Y51.259 F600
+G1 X37.937 Y51.169 F600
+G1 X37.744 Y51.115 F600
+G1 X37.575 Y51.101 F600
+G1 X15.415 Y51.101 F600
+G1 X15.216 Y51.121 F600
+G1 X15.025 Y51.180 F600
+G1 X14.850 Y51.276 F600
+G1 X14.739 Y51.364 F600
+G1 X14.710 Y51.391 F600
+G1 X14.737 Y51.362 F600
+G1 X14.856 Y51.202 F600
+G1 X14.942 Y51.022 F600
+G1 X14.990 Y50.828 F600
+G1 X15.000 Y50.686 F600
+G1 X15.000 Y31.041 F600
+G1 X15.000 Y27.041 F600
+G1 X15.000 Y15.416 F600
+G1 X14.980 Y15.217 F600
+G1 X14.921 Y15.026 F600
+G1 X14.825 Y14.851 F600
+G1 X14.737 Y14.740 F600
+G1 X14.719 Y14.720 F600
+G1 X14.738 Y14.737 F600
+G1 X14.898 Y14.857 F600
+G1 X15.079 Y14.942 F600
+G1 X15.273 Y14.990 F600
+G1 X15.414 Y15.000 F600
+G1 X69.736 Y15.000 F600
+G1 X69.935 Y14.980 F600
+G1 X70.126 Y14.921 F600
+G1 X70.301 Y14.825 F600
+G1 X70.412 Y14.737 F600
+G1 X70.431 Y14.720 F600
+G1 X70.414 Y14.739 F600
+G1 X70.294 Y14.899 F600
+G1 X70.209 Y15.080 F600
+G1 X70.161 Y15.274 F600
+G1 X70.151 Y15.415 F600
+G1 X70.151 Y50.687 F600
+G1 X70.171 Y50.886 F600
+G1 X70.230 Y51.077 F600
+G1 X70.326 Y51.252 F600
+G1 X70.414 Y51.363 F600
+G1 X70.440 Y51.391 F600
+G1 X70.411 Y51.364 F600
+G1 X70.251 Y51.245 F600
+G1 X70.071 Y51.159 F600
+G1 X69.877 Y51.111 F600
+G1 X69.735 Y51.101 F600
+G1 X64.976 Y51.101 F600
+G1 X60.976 Y51.101 F600
+G1 X47.575 Y51.101 F600
+G1 X47.376 Y51.121 F600
+G1 X47.185 Y51.180 F600
+G1 X47.010 Y51.276 F600
+G1 X46.857 Y51.405 F600
+G1 X46.733 Y51.561 F600
+G1 X46.643 Y51.739 F600
+G1 X46.589 Y51.932 F600
+G1 X46.575 Y52.101 F600
+G1 X46.575 Y61.101 F600
+G1 X46.595 Y61.300 F600
+G1 X46.654 Y61.491 F600
+G1 X46.750 Y61.666 F600
+G1 X46.879 Y61.819 F600
+G1 X47.035 Y61.943 F600
+G1 X47.213 Y62.033 F600
+G1 X47.406 Y62.087 F600
+G1 X47.575 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y10.177 F600
+G1 X81.151 Y6.177 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y36.141 F600
+G1 X4.000 Y40.141 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X37.575 Y62.101 F600
+G1 X37.774 Y62.081 F600
+G1 Z-3.000 F200
+G1 X37.965 Y62.022 F600
+G1 X38.140 Y61.926 F600
+G1 X38.293 Y61.797 F600
+G1 X38.417 Y61.641 F600
+G1 X38.507 Y61.463 F600
+G1 X38.561 Y61.270 F600
+G1 X38.575 Y61.101 F600
+G1 X38.575 Y52.101 F600
+G1 X38.555 Y51.902 F600
+G1 X38.496 Y51.711 F600
+G1 X38.400 Y51.536 F600
+G1 X38.271 Y51.383 F600
+G1 X38.115 Y51.259 F600
+G1 X37.937 Y51.169 F600
+G1 X37.744 Y51.115 F600
+G1 X37.575 Y51.101 F600
+G1 X15.415 Y51.101 F600
+G1 X15.216 Y51.121 F600
+G1 X15.025 Y51.180 F600
+G1 X14.850 Y51.276 F600
+G1 X14.739 Y51.364 F600
+G1 X14.710 Y51.391 F600
+G1 X14.737 Y51.362 F600
+G1 X14.856 Y51.202 F600
+G1 X14.942 Y51.022 F600
+G1 X14.990 Y50.828 F600
+G1 X15.000 Y50.686 F600
+G1 X15.000 Y31.041 F600
+G1 Z-2.000 F200
+G1 X15.000 Y27.041 F600
+G1 Z-3.000 F200
+G1 X15.000 Y15.416 F600
+G1 X14.980 Y15.217 F600
+G1 X14.921 Y15.026 F600
+G1 X14.825 Y14.851 F600
+G1 X14.737 Y14.740 F600
+G1 X14.719 Y14.720 F600
+G1 X14.738 Y14.737 F600
+G1 X14.898 Y14.857 F600
+G1 X15.079 Y14.942 F600
+G1 X15.273 Y14.990 F600
+G1 X15.414 Y15.000 F600
+G1 X69.736 Y15.000 F600
+G1 X69.935 Y14.980 F600
+G1 X70.126 Y14.921 F600
+G1 X70.301 Y14.825 F600
+G1 X70.412 Y14.737 F600
+G1 X70.431 Y14.720 F600
+G1 X70.414 Y14.739 F600
+G1 X70.294 Y14.899 F600
+G1 X70.209 Y15.080 F600
+G1 X70.161 Y15.274 F600
+G1 X70.151 Y15.415 F600
+G1 X70.151 Y50.687 F600
+G1 X70.171 Y50.886 F600
+G1 X70.230 Y51.077 F600
+G1 X70.326 Y51.252 F600
+G1 X70.414 Y51.363 F600
+G1 X70.440 Y51.391 F600
+G1 X70.411 Y51.364 F600
+G1 X70.251 Y51.245 F600
+G1 X70.071 Y51.159 F600
+G1 X69.877 Y51.111 F600
+G1 X69.735 Y51.101 F600
+G1 X64.976 Y51.101 F600
+G1 Z-2.000 F200
+G1 X60.976 Y51.101 F600
+G1 Z-3.000 F200
+G1 X47.575 Y51.101 F600
+G1 X47.376 Y51.121 F600
+G1 X47.185 Y51.180 F600
+G1 X47.010 Y51.276 F600
+G1 X46.857 Y51.405 F600
+G1 X46.733 Y51.561 F600
+G1 X46.643 Y51.739 F600
+G1 X46.589 Y51.932 F600
+G1 X46.575 Y52.101 F600
+G1 X46.575 Y61.101 F600
+G1 X46.595 Y61.300 F600
+G1 X46.654 Y61.491 F600
+G1 X46.750 Y61.666 F600
+G1 X46.879 Y61.819 F600
+G1 X47.035 Y61.943 F600
+G1 X47.213 Y62.033 F600
+G1 X47.406 Y62.087 F600
+G1 X47.575 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y10.177 F600
+G1 Z-2.000 F200
+G1 X81.151 Y6.177 F600
+G1 Z-3.000 F200
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y36.141 F600
+G1 Z-2.000 F200
+G1 X4.000 Y40.141 F600
+G1 Z-3.000 F200
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X37.575 Y62.101 F600
+G1 X37.774 Y62.081 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
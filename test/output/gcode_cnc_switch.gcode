@@ -0,0 +1,976 @@
+(gcode_cnc switch)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X17.262 Y48.837
+G1 Z-1.000 F200
+G1 X17.381 Y48.677 F600
+G1 X17.467 Y48.497 F600
+G1 X17.515 Y48.303 F600
+G1 X17.525 Y48.161 F600
+G1 X17.525 Y36.992 F600
+G1 X17.505 Y36.793 F600
+G1 X17.446 Y36.602 F600
+G1 X17.350 Y36.427 F600
+G1 X17.262 Y36.316 F600
+G1 X17.244 Y36.296 F600
+G1 X17.263 Y36.313 F600
+G1 X17.423 Y36.433 F600
+G1 X17.604 Y36.518 F600
+G1 X17.798 Y36.566 F600
+G1 X17.939 Y36.576 F600
+G1 X29.110 Y36.576 F600
+G1 X29.309 Y36.556 F600
+G1 X29.500 Y36.497 F600
+G1 X29.675 Y36.401 F600
+G1 X29.786 Y36.313 F600
+G1 X29.805 Y36.296 F600
+G1 X29.788 Y36.315 F600
+G1 X29.668 Y36.475 F600
+G1 X29.583 Y36.656 F600
+G1 X29.535 Y36.850 F600
+G1 X29.525 Y36.991 F600
+G1 X29.525 Y48.162 F600
+G1 X29.545 Y48.361 F600
+G1 X29.604 Y48.552 F600
+G1 X29.700 Y48.727 F600
+G1 X29.788 Y48.838 F600
+G1 X29.814 Y48.866 F600
+G1 X29.785 Y48.839 F600
+G1 X29.625 Y48.720 F600
+G1 X29.445 Y48.634 F600
+G1 X29.251 Y48.586 F600
+G1 X29.109 Y48.576 F600
+G1 X17.940 Y48.576 F600
+G1 X17.741 Y48.596 F600
+G1 X17.550 Y48.655 F600
+G1 X17.375 Y48.751 F600
+G1 X17.264 Y48.839 F600
+G1 X17.235 Y48.866 F600
+G1 X17.262 Y48.837 F600
+G1 Z-2.000 F200
+G1 X17.381 Y48.677 F600
+G1 X17.467 Y48.497 F600
+G1 X17.515 Y48.303 F600
+G1 X17.525 Y48.161 F600
+G1 X17.525 Y36.992 F600
+G1 X17.505 Y36.793 F600
+G1 X17.446 Y36.602 F600
+G1 X17.350 Y36.427 F600
+G1 X17.262 Y36.316 F600
+G1 X17.244 Y36.296 F600
+G1 X17.263 Y36.313 F600
+G1 X17.423 Y36.433 F600
+G1 X17.604 Y36.518 F600
+G1 X17.798 Y36.566 F600
+G1 X17.939 Y36.576 F600
+G1 X29.110 Y36.576 F600
+G1 X29.309 Y36.556 F600
+G1 X29.500 Y36.497 F600
+G1 X29.675 Y36.401 F600
+G1 X29.786 Y36.313 F600
+G1 X29.805 Y36.296 F600
+G1 X29.788 Y36.315 F600
+G1 X29.668 Y36.475 F600
+G1 X29.583 Y36.656 F600
+G1 X29.535 Y36.850 F600
+G1 X29.525 Y36.991 F600
+G1 X29.525 Y48.162 F600
+G1 X29.545 Y48.361 F600
+G1 X29.604 Y48.552 F600
+G1 X29.700 Y48.727 F600
+G1 X29.788 Y48.838 F600
+G1 X29.814 Y48.866 F600
+G1 X29.785 Y48.839 F600
+G1 X29.625 Y48.720 F600
+G1 X29.445 Y48.634 F600
+G1 X29.251 Y48.586 F600
+G1 X29.109 Y48.576 F600
+G1 X17.940 Y48.576 F600
+G1 X17.741 Y48.596 F600
+G1 X17.550 Y48.655 F600
+G1 X17.375 Y48.751 F600
+G1 X17.264 Y48.839 F600
+G1 X17.235 Y48.866 F600
+G1 X17.262 Y48.837 F600
+G1 Z-3.000 F200
+G1 X17.381 Y48.677 F600
+G1 X17.467 Y48.497 F600
+G1 X17.515 Y48.303 F600
+G1 X17.525 Y48.161 F600
+G1 X17.525 Y36.992 F600
+G1 X17.505 Y36.793 F600
+G1 X17.446 Y36.602 F600
+G1 X17.350 Y36.427 F600
+G1 X17.262 Y36.316 F600
+G1 X17.244 Y36.296 F600
+G1 X17.263 Y36.313 F600
+G1 X17.423 Y36.433 F600
+G1 X17.604 Y36.518 F600
+G1 X17.798 Y36.566 F600
+G1 X17.939 Y36.576 F600
+G1 X29.110 Y36.576 F600
+G1 X29.309 Y36.556 F600
+G1 X29.500 Y36.497 F600
+G1 X29.675 Y36.401 F600
+G1 X29.786 Y36.313 F600
+G1 X29.805 Y36.296 F600
+G1 X29.788 Y36.315 F600
+G1 X29.668 Y36.475 F600
+G1 X29.583 Y36.656 F600
+G1 X29.535 Y36.850 F600
+G1 X29.525 Y36.991 F600
+G1 X29.525 Y48.162 F600
+G1 X29.545 Y48.361 F600
+G1 X29.604 Y48.552 F600
+G1 X29.700 Y48.727 F600
+G1 X29.788 Y48.838 F600
+G1 X29.814 Y48.866 F600
+G1 X29.785 Y48.839 F600
+G1 X29.625 Y48.720 F600
+G1 X29.445 Y48.634 F600
+G1 X29.251 Y48.586 F600
+G1 X29.109 Y48.576 F600
+G1 X17.940 Y48.576 F600
+G1 X17.741 Y48.596 F600
+G1 X17.550 Y48.655 F600
+G1 X17.375 Y48.751 F600
+G1 X17.264 Y48.839 F600
+G1 X17.235 Y48.866 F600
+G1 X17.262 Y48.837 F600
+G0 Z5.000
+G0 X36.312 Y48.837
+G1 Z-1.000 F200
+G1 X36.431 Y48.677 F600
+G1 X36.517 Y48.497 F600
+G1 X36.565 Y48.303 F600
+G1 X36.575 Y48.161 F600
+G1 X36.575 Y36.992 F600
+G1 X36.555 Y36.793 F600
+G1 X36.496 Y36.602 F600
+G1 X36.400 Y36.427 F600
+G1 X36.312 Y36.316 F600
+G1 X36.294 Y36.296 F600
+G1 X36.313 Y36.313 F600
+G1 X36.473 Y36.433 F600
+G1 X36.654 Y36.518 F600
+G1 X36.848 Y36.566 F600
+G1 X36.989 Y36.576 F600
+G1 X48.160 Y36.576 F600
+G1 X48.359 Y36.556 F600
+G1 X48.550 Y36.497 F600
+G1 X48.725 Y36.401 F600
+G1 X48.836 Y36.313 F600
+G1 X48.855 Y36.296 F600
+G1 X48.838 Y36.315 F600
+G1 X48.718 Y36.475 F600
+G1 X48.633 Y36.656 F600
+G1 X48.585 Y36.850 F600
+G1 X48.575 Y36.991 F600
+G1 X48.575 Y48.162 F600
+G1 X48.595 Y48.361 F600
+G1 X48.654 Y48.552 F600
+G1 X48.750 Y48.727 F600
+G1 X48.838 Y48.838 F600
+G1 X48.864 Y48.866 F600
+G1 X48.835 Y48.839 F600
+G1 X48.675 Y48.720 F600
+G1 X48.495 Y48.634 F600
+G1 X48.301 Y48.586 F600
+G1 X48.159 Y48.576 F600
+G1 X36.990 Y48.576 F600
+G1 X36.791 Y48.596 F600
+G1 X36.600 Y48.655 F600
+G1 X36.425 Y48.751 F600
+G1 X36.314 Y48.839 F600
+G1 X36.285 Y48.866 F600
+G1 X36.312 Y48.837 F600
+G1 Z-2.000 F200
+G1 X36.431 Y48.677 F600
+G1 X36.517 Y48.497 F600
+G1 X36.565 Y48.303 F600
+G1 X36.575 Y48.161 F600
+G1 X36.575 Y36.992 F600
+G1 X36.555 Y36.793 F600
+G1 X36.496 Y36.602 F600
+G1 X36.400 Y36.427 F600
+G1 X36.312 Y36.316 F600
+G1 X36.294 Y36.296 F600
+G1 X36.313 Y36.313 F600
+G1 X36.473 Y36.433 F600
+G1 X36.654 Y36.518 F600
+G1 X36.848 Y36.566 F600
+G1 X36.989 Y36.576 F600
+G1 X48.160 Y36.576 F600
+G1 X48.359 Y36.556 F600
+G1 X48.550 Y36.497 F600
+G1 X48.725 Y36.401 F600
+G1 X48.836 Y36.313 F600
+G1 X48.855 Y36.296 F600
+G1 X48.838 Y36.315 F600
+G1 X48.718 Y36.475 F600
+G1 X48.633 Y36.656 F600
+G1 X48.585 Y36.850 F600
+G1 X48.575 Y36.991 F600
+G1 X48.575 Y48.162 F600
+G1 X48.595 Y48.361 F600
+G1 X48.654 Y48.552 F600
+G1 X48.750 Y48.727 F600
+G1 X48.838 Y48.838 F600
+G1 X48.864 Y48.866 F600
+G1 X48.835 Y48.839 F600
+G1 X48.675 Y48.720 F600
+G1 X48.495 Y48.634 F600
+G1 X48.301 Y48.586 F600
+G1 X48.159 Y48.576 F600
+G1 X36.990 Y48.576 F600
+G1 X36.791 Y48.596 F600
+G1 X36.600 Y48.655 F600
+G1 X36.425 Y48.751 F600
+G1 X36.314 Y48.839 F600
+G1 X36.285 Y48.866 F600
+G1 X36.312 Y48.837 F600
+G1 Z-3.000 F200
+G1 X36.431 Y48.677 F600
+G1 X36.517 Y48.497 F600
+G1 X36.565 Y48.303 F600
+G1 X36.575 Y48.161 F600
+G1 X36.575 Y36.992 F600
+G1 X36.555 Y36.793 F600
+G1 X36.496 Y36.602 F600
+G1 X36.400 Y36.427 F600
+G1 X36.312 Y36.316 F600
+G1 X36.294 Y36.296 F600
+G1 X36.313 Y36.313 F600
+G1 X36.473 Y36.433 F600
+G1 X36.654 Y36.518 F600
+G1 X36.848 Y36.566 F600
+G1 X36.989 Y36.576 F600
+G1 X48.160 Y36.576 F600
+G1 X48.359 Y36.556 F600
+G1 X48.550 Y36.497 F600
+G1 X48.725 Y36.401 F600
+G1 X48.836 Y36.313 F600
+G1 X48.855 Y36.296 F600
+G1 X48.838 Y36.315 F600
+G1 X48.718 Y36.475 F600
+G1 X48.633 Y36.656 F600
+G1 X48.585 Y36.850 F600
+G1 X48.575 Y36.991 F600
+G1 X48.575 Y48.162 F600
+G1 X48.595 Y48.361 F600
+G1 X48.654 Y48.552 F600
+G1 X48.750 Y48.727 F600
+G1 X48.838 Y48.838 F600
+G1 X48.864 Y48.866 F600
+G1 X48.835 Y48.839 F600
+G1 X48.675 Y48.720 F600
+G1 X48.495 Y48.634 F600
+G1 X48.301 Y48.586 F600
+G1 X48.159 Y48.576 F600
+G1 X36.990 Y48.576 F600
+G1 X36.791 Y48.596 F600
+G1 X36.600 Y48.655 F600
+G1 X36.425 Y48.751 F600
+G1 X36.314 Y48.839 F600
+G1 X36.285 Y48.866 F600
+G1 X36.312 Y48.837 F600
+G0 Z5.000
+G0 X36.312 Y29.787
+G1 Z-1.000 F200
+G1 X36.431 Y29.627 F600
+G1 X36.517 Y29.447 F600
+G1 X36.565 Y29.253 F600
+G1 X36.575 Y29.111 F600
+G1 X36.575 Y17.942 F600
+G1 X36.555 Y17.743 F600
+G1 X36.496 Y17.552 F600
+G1 X36.400 Y17.377 F600
+G1 X36.312 Y17.266 F600
+G1 X36.294 Y17.246 F600
+G1 X36.313 Y17.263 F600
+G1 X36.473 Y17.383 F600
+G1 X36.654 Y17.468 F600
+G1 X36.848 Y17.516 F600
+G1 X36.989 Y17.526 F600
+G1 X48.160 Y17.526 F600
+G1 X48.359 Y17.506 F600
+G1 X48.550 Y17.447 F600
+G1 X48.725 Y17.351 F600
+G1 X48.836 Y17.263 F600
+G1 X48.855 Y17.246 F600
+G1 X48.838 Y17.265 F600
+G1 X48.718 Y17.425 F600
+G1 X48.633 Y17.606 F600
+G1 X48.585 Y17.800 F600
+G1 X48.575 Y17.941 F600
+G1 X48.575 Y29.112 F600
+G1 X48.595 Y29.311 F600
+G1 X48.654 Y29.502 F600
+G1 X48.750 Y29.677 F600
+G1 X48.838 Y29.788 F600
+G1 X48.864 Y29.816 F600
+G1 X48.835 Y29.789 F600
+G1 X48.675 Y29.670 F600
+G1 X48.495 Y29.584 F600
+G1 X48.301 Y29.536 F600
+G1 X48.159 Y29.526 F600
+G1 X36.990 Y29.526 F600
+G1 X36.791 Y29.546 F600
+G1 X36.600 Y29.605 F600
+G1 X36.425 Y29.701 F600
+G1 X36.314 Y29.789 F600
+G1 X36.285 Y29.816 F600
+G1 X36.312 Y29.787 F600
+G1 Z-2.000 F200
+G1 X36.431 Y29.627 F600
+G1 X36.517 Y29.447 F600
+G1 X36.565 Y29.253 F600
+G1 X36.575 Y29.111 F600
+G1 X36.575 Y17.942 F600
+G1 X36.555 Y17.743 F600
+G1 X36.496 Y17.552 F600
+G1 X36.400 Y17.377 F600
+G1 X36.312 Y17.266 F600
+G1 X36.294 Y17.246 F600
+G1 X36.313 Y17.263 F600
+G1 X36.473 Y17.383 F600
+G1 X36.654 Y17.468 F600
+G1 X36.848 Y17.516 F600
+G1 X36.989 Y17.526 F600
+G1 X48.160 Y17.526 F600
+G1 X48.359 Y17.506 F600
+G1 X48.550 Y17.447 F600
+G1 X48.725 Y17.351 F600
+G1 X48.836 Y17.263 F600
+G1 X48.855 Y17.246 F600
+G1 X48.838 Y17.265 F600
+G1 X48.718 Y17.425 F600
+G1 X48.633 Y17.606 F600
+G1 X48.585 Y17.800 F600
+G1 X48.575 Y17.941 F600
+G1 X48.575 Y29.112 F600
+G1 X48.595 Y29.311 F600
+G1 X48.654 Y29.502 F600
+G1 X48.750 Y29.677 F600
+G1 X48.838 Y29.788 F600
+G1 X48.864 Y29.816 F600
+G1 X48.835 Y29.789 F600
+G1 X48.675 Y29.670 F600
+G1 X48.495 Y29.584 F600
+G1 X48.301 Y29.536 F600
+G1 X48.159 Y29.526 F600
+G1 X36.990 Y29.526 F600
+G1 X36.791 Y29.546 F600
+G1 X36.600 Y29.605 F600
+G1 X36.425 Y29.701 F600
+G1 X36.314 Y29.789 F600
+G1 X36.285 Y29.816 F600
+G1 X36.312 Y29.787 F600
+G1 Z-3.000 F200
+G1 X36.431 Y29.627 F600
+G1 X36.517 Y29.447 F600
+G1 X36.565 Y29.253 F600
+G1 X36.575 Y29.111 F600
+G1 X36.575 Y17.942 F600
+G1 X36.555 Y17.743 F600
+G1 X36.496 Y17.552 F600
+G1 X36.400 Y17.377 F600
+G1 X36.312 Y17.266 F600
+G1 X36.294 Y17.246 F600
+G1 X36.313 Y17.263 F600
+G1 X36.473 Y17.383 F600
+G1 X36.654 Y17.468 F600
+G1 X36.848 Y17.516 F600
+G1 X36.989 Y17.526 F600
+G1 X48.160 Y17.526 F600
+G1 X48.359 Y17.506 F600
+G1 X48.550 Y17.447 F600
+G1 X48.725 Y17.351 F600
+G1 X48.836 Y17.263 F600
+G1 X48.855 Y17.246 F600
+G1 X48.838 Y17.265 F600
+G1 X48.718 Y17.425 F600
+G1 X48.633 Y17.606 F600
+G1 X48.585 Y17.800 F600
+G1 X48.575 Y17.941 F600
+G1 X48.575 Y29.112 F600
+G1 X48.595 Y29.311 F600
+G1 X48.654 Y29.502 F600
+G1 X48.750 Y29.677 F600
+G1 X48.838 Y29.788 F600
+G1 X48.864 Y29.816 F600
+G1 X48.835 Y29.789 F600
+G1 X48.675 Y29.670 F600
+G1 X48.495 Y29.584 F600
+G1 X48.301 Y29.536 F600
+G1 X48.159 Y29.526 F600
+G1 X36.990 Y29.526 F600
+G1 X36.791 Y29.546 F600
+G1 X36.600 Y29.605 F600
+G1 X36.425 Y29.701 F600
+G1 X36.314 Y29.789 F600
+G1 X36.285 Y29.816 F600
+G1 X36.312 Y29.787 F600
+G0 Z5.000
+G0 X17.262 Y29.787
+G1 Z-1.000 F200
+G1 X17.381 Y29.627 F600
+G1 X17.467 Y29.447 F600
+G1 X17.515 Y29.253 F600
+G1 X17.525 Y29.111 F600
+G1 X17.525 Y17.942 F600
+G1 X17.505 Y17.743 F600
+G1 X17.446 Y17.552 F600
+G1 X17.350 Y17.377 F600
+G1 X17.262 Y17.266 F600
+G1 X17.244 Y17.246 F600
+G1 X17.263 Y17.263 F600
+G1 X17.423 Y17.383 F600
+G1 X17.604 Y17.468 F600
+G1 X17.798 Y17.516 F600
+G1 X17.939 Y17.526 F600
+G1 X29.110 Y17.526 F600
+G1 X29.309 Y17.506 F600
+G1 X29.500 Y17.447 F600
+G1 X29.675 Y17.351 F600
+G1 X29.786 Y17.263 F600
+G1 X29.805 Y17.246 F600
+G1 X29.788 Y17.265 F600
+G1 X29.668 Y17.425 F600
+G1 X29.583 Y17.606 F600
+G1 X29.535 Y17.800 F600
+G1 X29.525 Y17.941 F600
+G1 X29.525 Y29.112 F600
+G1 X29.545 Y29.311 F600
+G1 X29.604 Y29.502 F600
+G1 X29.700 Y29.677 F600
+G1 X29.788 Y29.788 F600
+G1 X29.814 Y29.816 F600
+G1 X29.785 Y29.789 F600
+G1 X29.625 Y29.670 F600
+G1 X29.445 Y29.584 F600
+G1 X29.251 Y29.536 F600
+G1 X29.109 Y29.526 F600
+G1 X17.940 Y29.526 F600
+G1 X17.741 Y29.546 F600
+G1 X17.550 Y29.605 F600
+G1 X17.375 Y29.701 F600
+G1 X17.264 Y29.789 F600
+G1 X17.235 Y29.816 F600
+G1 X17.262 Y29.787 F600
+G1 Z-2.000 F200
+G1 X17.381 Y29.627 F600
+G1 X17.467 Y29.447 F600
+G1 X17.515 Y29.253 F600
+G1 X17.525 Y29.111 F600
+G1 X17.525 Y17.942 F600
+G1 X17.505 Y17.743 F600
+G1 X17.446 Y17.552 F600
+G1 X17.350 Y17.377 F600
+G1 X17.262 Y17.266 F600
+G1 X17.244 Y17.246 F600
+G1 X17.263 Y17.263 F600
+G1 X17.423 Y17.383 F600
+G1 X17.604 Y17.468 F600
+G1 X17.798 Y17.516 F600
+G1 X17.939 Y17.526 F600
+G1 X29.110 Y17.526 F600
+G1 X29.309 Y17.506 F600
+G1 X29.500 Y17.447 F600
+G1 X29.675 Y17.351 F600
+G1 X29.786 Y17.263 F600
+G1 X29.805 Y17.246 F600
+G1 X29.788 Y17.265 F600
+G1 X29.668 Y17.425 F600
+G1 X29.583 Y17.606 F600
+G1 X29.535 Y17.800 F600
+G1 X29.525 Y17.941 F600
+G1 X29.525 Y29.112 F600
+G1 X29.545 Y29.311 F600
+G1 X29.604 Y29.502 F600
+G1 X29.700 Y29.677 F600
+G1 X29.788 Y29.788 F600
+G1 X29.814 Y29.816 F600
+G1 X29.785 Y29.789 F600
+G1 X29.625 Y29.670 F600
+G1 X29.445 Y29.584 F600
+G1 X29.251 Y29.536 F600
+G1 X29.109 Y29.526 F600
+G1 X17.940 Y29.526 F600
+G1 X17.741 Y29.546 F600
+G1 X17.550 Y29.605 F600
+G1 X17.375 Y29.701 F600
+G1 X17.264 Y29.789 F600
+G1 X17.235 Y29.816 F600
+G1 X17.262 Y29.787 F600
+G1 Z-3.000 F200
+G1 X17.381 Y29.627 F600
+G1 X17.467 Y29.447 F600
+G1 X17.515 Y29.253 F600
+G1 X17.525 Y29.111 F600
+G1 X17.525 Y17.942 F600
+G1 X17.505 Y17.743 F600
+G1 X17.446 Y17.552 F600
+G1 X17.350 Y17.377 F600
+G1 X17.262 Y17.266 F600
+G1 X17.244 Y17.246 F600
+G1 X17.263 Y17.263 F600
+G1 X17.423 Y17.383 F600
+G1 X17.604 Y17.468 F600
+G1 X17.798 Y17.516 F600
+G1 X17.939 Y17.526 F600
+G1 X29.110 Y17.526 F600
+G1 X29.309 Y17.506 F600
+G1 X29.500 Y17.447 F600
+G1 X29.675 Y17.351 F600
+G1 X29.786 Y17.263 F600
+G1 X29.805 Y17.246 F600
+G1 X29.788 Y17.265 F600
+G1 X29.668 Y17.425 F600
+G1 X29.583 Y17.606 F600
+G1 X29.535 Y17.800 F600
+G1 X29.525 Y17.941 F600
+G1 X29.525 Y29.112 F600
+G1 X29.545 Y29.311 F600
+G1 X29.604 Y29.502 F600
+G1 X29.700 Y29.677 F600
+G1 X29.788 Y29.788 F600
+G1 X29.814 Y29.816 F600
+G1 X29.785 Y29.789 F600
+G1 X29.625 Y29.670 F600
+G1 X29.445 Y29.584 F600
+G1 X29.251 Y29.536 F600
+G1 X29.109 Y29.526 F600
+G1 X17.940 Y29.526 F600
+G1 X17.741 Y29.546 F600
+G1 X17.550 Y29.605 F600
+G1 X17.375 Y29.701 F600
+G1 X17.264 Y29.789 F600
+G1 X17.235 Y29.816 F600
+G1 X17.262 Y29.787 F600
+G0 Z5.000
+G0 X55.362 Y29.787
+G1 Z-1.000 F200
+G1 X55.481 Y29.627 F600
+G1 X55.567 Y29.447 F600
+G1 X55.615 Y29.253 F600
+G1 X55.625 Y29.111 F600
+G1 X55.625 Y17.942 F600
+G1 X55.605 Y17.743 F600
+G1 X55.546 Y17.552 F600
+G1 X55.450 Y17.377 F600
+G1 X55.362 Y17.266 F600
+G1 X55.344 Y17.246 F600
+G1 X55.363 Y17.263 F600
+G1 X55.523 Y17.383 F600
+G1 X55.704 Y17.468 F600
+G1 X55.898 Y17.516 F600
+G1 X56.039 Y17.526 F600
+G1 X67.210 Y17.526 F600
+G1 X67.409 Y17.506 F600
+G1 X67.600 Y17.447 F600
+G1 X67.775 Y17.351 F600
+G1 X67.886 Y17.263 F600
+G1 X67.905 Y17.246 F600
+G1 X67.888 Y17.265 F600
+G1 X67.768 Y17.425 F600
+G1 X67.683 Y17.606 F600
+G1 X67.635 Y17.800 F600
+G1 X67.625 Y17.941 F600
+G1 X67.625 Y29.112 F600
+G1 X67.645 Y29.311 F600
+G1 X67.704 Y29.502 F600
+G1 X67.800 Y29.677 F600
+G1 X67.888 Y29.788 F600
+G1 X67.914 Y29.816 F600
+G1 X67.885 Y29.789 F600
+G1 X67.725 Y29.670 F600
+G1 X67.545 Y29.584 F600
+G1 X67.351 Y29.536 F600
+G1 X67.209 Y29.526 F600
+G1 X56.040 Y29.526 F600
+G1 X55.841 Y29.546 F600
+G1 X55.650 Y29.605 F600
+G1 X55.475 Y29.701 F600
+G1 X55.364 Y29.789 F600
+G1 X55.335 Y29.816 F600
+G1 X55.362 Y29.787 F600
+G1 Z-2.000 F200
+G1 X55.481 Y29.627 F600
+G1 X55.567 Y29.447 F600
+G1 X55.615 Y29.253 F600
+G1 X55.625 Y29.111 F600
+G1 X55.625 Y17.942 F600
+G1 X55.605 Y17.743 F600
+G1 X55.546 Y17.552 F600
+G1 X55.450 Y17.377 F600
+G1 X55.362 Y17.266 F600
+G1 X55.344 Y17.246 F600
+G1 X55.363 Y17.263 F600
+G1 X55.523 Y17.383 F600
+G1 X55.704 Y17.468 F600
+G1 X55.898 Y17.516 F600
+G1 X56.039 Y17.526 F600
+G1 X67.210 Y17.526 F600
+G1 X67.409 Y17.506 F600
+G1 X67.600 Y17.447 F600
+G1 X67.775 Y17.351 F600
+G1 X67.886 Y17.263 F600
+G1 X67.905 Y17.246 F600
+G1 X67.888 Y17.265 F600
+G1 X67.768 Y17.425 F600
+G1 X67.683 Y17.606 F600
+G1 X67.635 Y17.800 F600
+G1 X67.625 Y17.941 F600
+G1 X67.625 Y29.112 F600
+G1 X67.645 Y29.311 F600
+G1 X67.704 Y29.502 F600
+G1 X67.800 Y29.677 F600
+G1 X67.888 Y29.788 F600
+G1 X67.914 Y29.816 F600
+G1 X67.885 Y29.789 F600
+G1 X67.725 Y29.670 F600
+G1 X67.545 Y29.584 F600
+G1 X67.351 Y29.536 F600
+G1 X67.209 Y29.526 F600
+G1 X56.040 Y29.526 F600
+G1 X55.841 Y29.546 F600
+G1 X55.650 Y29.605 F600
+G1 X55.475 Y29.701 F600
+G1 X55.364 Y29.789 F600
+G1 X55.335 Y29.816 F600
+G1 X55.362 Y29.787 F600
+G1 Z-3.000 F200
+G1 X55.481 Y29.627 F600
+G1 X55.567 Y29.447 F600
+G1 X55.615 Y29.253 F600
+G1 X55.625 Y29.111 F600
+G1 X55.625 Y17.942 F600
+G1 X55.605 Y17.743 F600
+G1 X55.546 Y17.552 F600
+G1 X55.450 Y17.377 F600
+G1 X55.362 Y17.266 F600
+G1 X55.344 Y17.246 F600
+G1 X55.363 Y17.263 F600
+G1 X55.523 Y17.383 F600
+G1 X55.704 Y17.468 F600
+G1 X55.898 Y17.516 F600
+G1 X56.039 Y17.526 F600
+G1 X67.210 Y17.526 F600
+G1 X67.409 Y17.506 F600
+G1 X67.600 Y17.447 F600
+G1 X67.775 Y17.351 F600
+G1 X67.886 Y17.263 F600
+G1 X67.905 Y17.246 F600
+G1 X67.888 Y17.265 F600
+G1 X67.768 Y17.425 F600
+G1 X67.683 Y17.606 F600
+G1 X67.635 Y17.800 F600
+G1 X67.625 Y17.941 F600
+G1 X67.625 Y29.112 F600
+G1 X67.645 Y29.311 F600
+G1 X67.704 Y29.502 F600
+G1 X67.800 Y29.677 F600
+G1 X67.888 Y29.788 F600
+G1 X67.914 Y29.816 F600
+G1 X67.885 Y29.789 F600
+G1 X67.725 Y29.670 F600
+G1 X67.545 Y29.584 F600
+G1 X67.351 Y29.536 F600
+G1 X67.209 Y29.526 F600
+G1 X56.040 Y29.526 F600
+G1 X55.841 Y29.546 F600
+G1 X55.650 Y29.605 F600
+G1 X55.475 Y29.701 F600
+G1 X55.364 Y29.789 F600
+G1 X55.335 Y29.816 F600
+G1 X55.362 Y29.787 F600
+G0 Z5.000
+G0 X67.885 Y48.839
+G1 Z-1.000 F200
+G1 X67.725 Y48.720 F600
+G1 X67.545 Y48.634 F600
+G1 X67.351 Y48.586 F600
+G1 X67.209 Y48.576 F600
+G1 X56.040 Y48.576 F600
+G1 X55.841 Y48.596 F600
+G1 X55.650 Y48.655 F600
+G1 X55.475 Y48.751 F600
+G1 X55.364 Y48.839 F600
+G1 X55.335 Y48.866 F600
+G1 X55.362 Y48.837 F600
+G1 X55.481 Y48.677 F600
+G1 X55.567 Y48.497 F600
+G1 X55.615 Y48.303 F600
+G1 X55.625 Y48.161 F600
+G1 X55.625 Y36.992 F600
+G1 X55.605 Y36.793 F600
+G1 X55.546 Y36.602 F600
+G1 X55.450 Y36.427 F600
+G1 X55.362 Y36.316 F600
+G1 X55.344 Y36.296 F600
+G1 X55.363 Y36.313 F600
+G1 X55.523 Y36.433 F600
+G1 X55.704 Y36.518 F600
+G1 X55.898 Y36.566 F600
+G1 X56.039 Y36.576 F600
+G1 X67.210 Y36.576 F600
+G1 X67.409 Y36.556 F600
+G1 X67.600 Y36.497 F600
+G1 X67.775 Y36.401 F600
+G1 X67.886 Y36.313 F600
+G1 X67.905 Y36.296 F600
+G1 X67.888 Y36.315 F600
+G1 X67.768 Y36.475 F600
+G1 X67.683 Y36.656 F600
+G1 X67.635 Y36.850 F600
+G1 X67.625 Y36.991 F600
+G1 X67.625 Y48.162 F600
+G1 X67.645 Y48.361 F600
+G1 X67.704 Y48.552 F600
+G1 X67.800 Y48.727 F600
+G1 X67.888 Y48.838 F600
+G1 X67.914 Y48.866 F600
+G1 X67.885 Y48.839 F600
+G1 Z-2.000 F200
+G1 X67.725 Y48.720 F600
+G1 X67.545 Y48.634 F600
+G1 X67.351 Y48.586 F600
+G1 X67.209 Y48.576 F600
+G1 X56.040 Y48.576 F600
+G1 X55.841 Y48.596 F600
+G1 X55.650 Y48.655 F600
+G1 X55.475 Y48.751 F600
+G1 X55.364 Y48.839 F600
+G1 X55.335 Y48.866 F600
+G1 X55.362 Y48.837 F600
+G1 X55.481 Y48.677 F600
+G1 X55.567 Y48.497 F600
+G1 X55.615 Y48.303 F600
+G1 X55.625 Y48.161 F600
+G1 X55.625 Y36.992 F600
+G1 X55.605 Y36.793 F600
+G1 X55.546 Y36.602 F600
+G1 X55.450 Y36.427 F600
+G1 X55.362 Y36.316 F600
+G1 X55.344 Y36.296 F600
+G1 X55.363 Y36.313 F600
+G1 X55.523 Y36.433 F600
+G1 X55.704 Y36.518 F600
+G1 X55.898 Y36.566 F600
+G1 X56.039 Y36.576 F600
+G1 X67.210 Y36.576 F600
+G1 X67.409 Y36.556 F600
+G1 X67.600 Y36.497 F600
+G1 X67.775 Y36.401 F600
+G1 X67.886 Y36.313 F600
+G1 X67.905 Y36.296 F600
+G1 X67.888 Y36.315 F600
+G1 X67.768 Y36.475 F600
+G1 X67.683 Y36.656 F600
+G1 X67.635 Y36.850 F600
+G1 X67.625 Y36.991 F600
+G1 X67.625 Y48.162 F600
+G1 X67.645 Y48.361 F600
+G1 X67.704 Y48.552 F600
+G1 X67.800 Y48.727 F600
+G1 X67.888 Y48.838 F600
+G1 X67.914 Y48.866 F600
+G1 X67.885 Y48.839 F600
+G1 Z-3.000 F200
+G1 X67.725 Y48.720 F600
+G1 X67.545 Y48.634 F600
+G1 X67.351 Y48.586 F600
+G1 X67.209 Y48.576 F600
+G1 X56.040 Y48.576 F600
+G1 X55.841 Y48.596 F600
+G1 X55.650 Y48.655 F600
+G1 X55.475 Y48.751 F600
+G1 X55.364 Y48.839 F600
+G1 X55.335 Y48.866 F600
+G1 X55.362 Y48.837 F600
+G1 X55.481 Y48.677 F600
+G1 X55.567 Y48.497 F600
+G1 X55.615 Y48.303 F600
+G1 X55.625 Y48.161 F600
+G1 X55.625 Y36.992 F600
+G1 X55.605 Y36.793 F600
+G1 X55.546 Y36.602 F600
+G1 X55.450 Y36.427 F600
+G1 X55.362 Y36.316 F600
+G1 X55.344 Y36.296 F600
+G1 X55.363 Y36.313 F600
+G1 X55.523 Y36.433 F600
+G1 X55.704 Y36.518 F600
+G1 X55.898 Y36.566 F600
+G1 X56.039 Y36.576 F600
+G1 X67.210 Y36.576 F600
+G1 X67.409 Y36.556 F600
+G1 X67.600 Y36.497 F600
+G1 X67.775 Y36.401 F600
+G1 X67.886 Y36.313 F600
+G1 X67.905 Y36.296 F600
+G1 X67.888 Y36.315 F600
+G1 X67.768 Y36.475 F600
+G1 X67.683 Y36.656 F600
+G1 X67.635 Y36.850 F600
+G1 X67.625 Y36.991 F600
+G1 X67.625 Y48.162 F600
+G1 X67.645 Y48.361 F600
+G1 X67.704 Y48.552 F600
+G1 X67.800 Y48.727 F600
+G1 X67.888 Y48.838 F600
+G1 X67.914 Y48.866 F600
+G1 X67.885 Y48.839 F600
+G0 Z5.000
+G0 X80.350 Y62.081
+G1 Z-1.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 X81.151 Y26.872 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 X36.397 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 X4.000 Y39.229 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 X48.754 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 Z-2.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 X81.151 Y26.872 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 X36.397 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 X4.000 Y39.229 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 X48.754 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 Z-3.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 Z-2.000 F200
+G1 X81.151 Y26.872 F600
+G1 Z-3.000 F200
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 Z-2.000 F200
+G1 X36.397 Y4.000 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 Z-2.000 F200
+G1 X4.000 Y39.229 F600
+G1 Z-3.000 F200
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 Z-2.000 F200
+G1 X48.754 Y62.101 F600
+G1 Z-3.000 F200
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
@@ -0,0 +1,291 @@
+(gcode_cnc top)
+G21
+G90
+G0 Z5.000
+M3 S12000
+G4 P2
+G0 X14.735 Y51.364
+G1 Z-1.000 F200
+G1 X14.854 Y51.204 F600
+G1 X14.940 Y51.024 F600
+G1 X14.988 Y50.830 F600
+G1 X14.998 Y50.688 F600
+G1 X14.998 Y15.416 F600
+G1 X14.978 Y15.217 F600
+G1 X14.919 Y15.026 F600
+G1 X14.823 Y14.851 F600
+G1 X14.735 Y14.740 F600
+G1 X14.717 Y14.720 F600
+G1 X14.736 Y14.737 F600
+G1 X14.896 Y14.857 F600
+G1 X15.077 Y14.942 F600
+G1 X15.271 Y14.990 F600
+G1 X15.412 Y15.000 F600
+G1 X69.736 Y15.000 F600
+G1 X69.935 Y14.980 F600
+G1 X70.126 Y14.921 F600
+G1 X70.301 Y14.825 F600
+G1 X70.412 Y14.737 F600
+G1 X70.431 Y14.720 F600
+G1 X70.414 Y14.739 F600
+G1 X70.294 Y14.899 F600
+G1 X70.209 Y15.080 F600
+G1 X70.161 Y15.274 F600
+G1 X70.151 Y15.415 F600
+G1 X70.151 Y50.689 F600
+G1 X70.171 Y50.888 F600
+G1 X70.230 Y51.079 F600
+G1 X70.326 Y51.254 F600
+G1 X70.414 Y51.365 F600
+G1 X70.440 Y51.393 F600
+G1 X70.411 Y51.366 F600
+G1 X70.251 Y51.247 F600
+G1 X70.071 Y51.161 F600
+G1 X69.877 Y51.113 F600
+G1 X69.735 Y51.103 F600
+G1 X15.413 Y51.103 F600
+G1 X15.214 Y51.123 F600
+G1 X15.023 Y51.182 F600
+G1 X14.848 Y51.278 F600
+G1 X14.737 Y51.366 F600
+G1 X14.708 Y51.393 F600
+G1 X14.735 Y51.364 F600
+G1 Z-2.000 F200
+G1 X14.854 Y51.204 F600
+G1 X14.940 Y51.024 F600
+G1 X14.988 Y50.830 F600
+G1 X14.998 Y50.688 F600
+G1 X14.998 Y15.416 F600
+G1 X14.978 Y15.217 F600
+G1 X14.919 Y15.026 F600
+G1 X14.823 Y14.851 F600
+G1 X14.735 Y14.740 F600
+G1 X14.717 Y14.720 F600
+G1 X14.736 Y14.737 F600
+G1 X14.896 Y14.857 F600
+G1 X15.077 Y14.942 F600
+G1 X15.271 Y14.990 F600
+G1 X15.412 Y15.000 F600
+G1 X69.736 Y15.000 F600
+G1 X69.935 Y14.980 F600
+G1 X70.126 Y14.921 F600
+G1 X70.301 Y14.825 F600
+G1 X70.412 Y14.737 F600
+G1 X70.431 Y14.720 F600
+G1 X70.414 Y14.739 F600
+G1 X70.294 Y14.899 F600
+G1 X70.209 Y15.080 F600
+G1 X70.161 Y15.274 F600
+G1 X70.151 Y15.415 F600
+G1 X70.151 Y50.689 F600
+G1 X70.171 Y50.888 F600
+G1 X70.230 Y51.079 F600
+G1 X70.326 Y51.254 F600
+G1 X70.414 Y51.365 F600
+G1 X70.440 Y51.393 F600
+G1 X70.411 Y51.366 F600
+G1 X70.251 Y51.247 F600
+G1 X70.071 Y51.161 F600
+G1 X69.877 Y51.113 F600
+G1 X69.735 Y51.103 F600
+G1 X15.413 Y51.103 F600
+G1 X15.214 Y51.123 F600
+G1 X15.023 Y51.182 F600
+G1 X14.848 Y51.278 F600
+G1 X14.737 Y51.366 F600
+G1 X14.708 Y51.393 F600
+G1 X14.735 Y51.364 F600
+G1 Z-3.000 F200
+G1 X14.854 Y51.204 F600
+G1 X14.940 Y51.024 F600
+G1 X14.988 Y50.830 F600
+G1 X14.998 Y50.688 F600
+G1 X14.998 Y15.416 F600
+G1 X14.978 Y15.217 F600
+G1 X14.919 Y15.026 F600
+G1 X14.823 Y14.851 F600
+G1 X14.735 Y14.740 F600
+G1 X14.717 Y14.720 F600
+G1 X14.736 Y14.737 F600
+G1 X14.896 Y14.857 F600
+G1 X15.077 Y14.942 F600
+G1 X15.271 Y14.990 F600
+G1 X15.412 Y15.000 F600
+G1 X69.736 Y15.000 F600
+G1 X69.935 Y14.980 F600
+G1 X70.126 Y14.921 F600
+G1 X70.301 Y14.825 F600
+G1 X70.412 Y14.737 F600
+G1 X70.431 Y14.720 F600
+G1 X70.414 Y14.739 F600
+G1 X70.294 Y14.899 F600
+G1 X70.209 Y15.080 F600
+G1 X70.161 Y15.274 F600
+G1 X70.151 Y15.415 F600
+G1 X70.151 Y50.689 F600
+G1 X70.171 Y50.888 F600
+G1 X70.230 Y51.079 F600
+G1 X70.326 Y51.254 F600
+G1 X70.414 Y51.365 F600
+G1 X70.440 Y51.393 F600
+G1 X70.411 Y51.366 F600
+G1 X70.251 Y51.247 F600
+G1 X70.071 Y51.161 F600
+G1 X69.877 Y51.113 F600
+G1 X69.735 Y51.103 F600
+G1 X15.413 Y51.103 F600
+G1 X15.214 Y51.123 F600
+G1 X15.023 Y51.182 F600
+G1 X14.848 Y51.278 F600
+G1 X14.737 Y51.366 F600
+G1 X14.708 Y51.393 F600
+G1 X14.735 Y51.364 F600
+G0 Z5.000
+G0 X80.350 Y62.081
+G1 Z-1.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 X81.151 Y26.872 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 X36.397 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 X4.000 Y39.229 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 X48.754 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 Z-2.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 X81.151 Y26.872 F600
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 X36.397 Y4.000 F600
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 X4.000 Y39.229 F600
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 X48.754 Y62.101 F600
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G1 Z-3.000 F200
+G1 X80.541 Y62.022 F600
+G1 X80.716 Y61.926 F600
+G1 X80.869 Y61.797 F600
+G1 X80.993 Y61.641 F600
+G1 X81.083 Y61.463 F600
+G1 X81.137 Y61.270 F600
+G1 X81.151 Y61.101 F600
+G1 X81.151 Y30.872 F600
+G1 Z-2.000 F200
+G1 X81.151 Y26.872 F600
+G1 Z-3.000 F200
+G1 X81.151 Y5.000 F600
+G1 X81.131 Y4.801 F600
+G1 X81.072 Y4.610 F600
+G1 X80.976 Y4.435 F600
+G1 X80.847 Y4.282 F600
+G1 X80.691 Y4.158 F600
+G1 X80.513 Y4.068 F600
+G1 X80.320 Y4.014 F600
+G1 X80.151 Y4.000 F600
+G1 X40.397 Y4.000 F600
+G1 Z-2.000 F200
+G1 X36.397 Y4.000 F600
+G1 Z-3.000 F200
+G1 X5.000 Y4.000 F600
+G1 X4.801 Y4.020 F600
+G1 X4.610 Y4.079 F600
+G1 X4.435 Y4.175 F600
+G1 X4.282 Y4.304 F600
+G1 X4.158 Y4.460 F600
+G1 X4.068 Y4.638 F600
+G1 X4.014 Y4.831 F600
+G1 X4.000 Y5.000 F600
+G1 X4.000 Y35.229 F600
+G1 Z-2.000 F200
+G1 X4.000 Y39.229 F600
+G1 Z-3.000 F200
+G1 X4.000 Y61.101 F600
+G1 X4.020 Y61.300 F600
+G1 X4.079 Y61.491 F600
+G1 X4.175 Y61.666 F600
+G1 X4.304 Y61.819 F600
+G1 X4.460 Y61.943 F600
+G1 X4.638 Y62.033 F600
+G1 X4.831 Y62.087 F600
+G1 X5.000 Y62.101 F600
+G1 X44.754 Y62.101 F600
+G1 Z-2.000 F200
+G1 X48.754 Y62.101 F600
+G1 Z-3.000 F200
+G1 X80.151 Y62.101 F600
+G1 X80.350 Y62.081 F600
+G0 Z5.000
+M5
+G0 X0 Y0
+M2
@@ -0,0 +1,44 @@
+(gcode_laser bottom)
+G21
+G90
+M4 S0
+G0 X80.151 Y5.000
+G1 X49.338 Y5.000 F600 S1000
+G1 X45.338 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y26.288 F600 S1000
+G1 X5.000 Y30.288 F600 S0
+G1 X5.000 Y61.101 F600 S1000
+G1 X35.813 Y61.101 F600 S1000
+G1 X39.813 Y61.101 F600 S0
+G1 X80.151 Y61.101 F600 S1000
+G1 X80.151 Y39.813 F600 S1000
+G1 X80.151 Y35.813 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+G1 X49.338 Y5.000 F600 S1000
+G1 X45.338 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y26.288 F600 S1000
+G1 X5.000 Y30.288 F600 S0
+G1 X5.000 Y61.101 F600 S1000
+G1 X35.813 Y61.101 F600 S1000
+G1 X39.813 Y61.101 F600 S0
+G1 X80.151 Y61.101 F600 S1000
+G1 X80.151 Y39.813 F600 S1000
+G1 X80.151 Y35.813 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+G1 X49.338 Y5.000 F600 S1000
+G1 X45.338 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y26.288 F600 S1000
+G1 X5.000 Y30.288 F600 S0
+G1 X5.000 Y61.101 F600 S1000
+G1 X35.813 Y61.101 F600 S1000
+G1 X39.813 Y61.101 F600 S0
+G1 X80.151 Y61.101 F600 S1000
+G1 X80.151 Y39.813 F600 S1000
+G1 X80.151 Y35.813 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+M5
+G0 X0 Y0
+M2
@@ -0,0 +1,68 @@
+(gcode_laser open)
+G21
+G90
+M4 S0
+G0 X80.151 Y5.000
+G1 X25.775 Y5.000 F600 S1000
+G1 X21.775 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y61.101 F600 S1000
+G1 X37.575 Y61.101 F600 S1000
+G1 X37.575 Y57.800 F600 S1000
+G1 X37.575 Y53.800 F600 S0
+G1 X37.575 Y52.101 F600 S1000
+G1 X14.000 Y52.101 F600 S1000
+G1 X14.000 Y14.000 F600 S1000
+G1 X59.377 Y14.000 F600 S1000
+G1 X63.377 Y14.000 F600 S0
+G1 X71.151 Y14.000 F600 S1000
+G1 X71.151 Y52.101 F600 S1000
+G1 X47.575 Y52.101 F600 S1000
+G1 X47.575 Y61.101 F600 S1000
+G1 X77.876 Y61.101 F600 S1000
+G1 X80.151 Y61.101 F600 S0
+G1 X80.151 Y59.376 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+G1 X25.775 Y5.000 F600 S1000
+G1 X21.775 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y61.101 F600 S1000
+G1 X37.575 Y61.101 F600 S1000
+G1 X37.575 Y57.800 F600 S1000
+G1 X37.575 Y53.800 F600 S0
+G1 X37.575 Y52.101 F600 S1000
+G1 X14.000 Y52.101 F600 S1000
+G1 X14.000 Y14.000 F600 S1000
+G1 X59.377 Y14.000 F600 S1000
+G1 X63.377 Y14.000 F600 S0
+G1 X71.151 Y14.000 F600 S1000
+G1 X71.151 Y52.101 F600 S1000
+G1 X47.575 Y52.101 F600 S1000
+G1 X47.575 Y61.101 F600 S1000
+G1 X77.876 Y61.101 F600 S1000
+G1 X80.151 Y61.101 F600 S0
+G1 X80.151 Y59.376 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+G1 X25.775 Y5.000 F600 S1000
+G1 X21.775 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y61.101 F600 S1000
+G1 X37.575 Y61.101 F600 S1000
+G1 X37.575 Y57.800 F600 S1000
+G1 X37.575 Y53.800 F600 S0
+G1 X37.575 Y52.101 F600 S1000
+G1 X14.000 Y52.101 F600 S1000
+G1 X14.000 Y14.000 F600 S1000
+G1 X59.377 Y14.000 F600 S1000
+G1 X63.377 Y14.000 F600 S0
+G1 X71.151 Y14.000 F600 S1000
+G1 X71.151 Y52.101 F600 S1000
+G1 X47.575 Y52.101 F600 S1000
+G1 X47.575 Y61.101 F600 S1000
+G1 X77.876 Y61.101 F600 S1000
+G1 X80.151 Y61.101 F600 S0
+G1 X80.151 Y59.376 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+M5
+G0 X0 Y0
+M2
@@ -0,0 +1,122 @@
+(gcode_laser switch)
+G21
+G90
+M4 S0
+G0 X16.525 Y49.576
+G1 X16.525 Y35.576 F600 S1000
+G1 X30.525 Y35.576 F600 S1000
+G1 X30.525 Y49.576 F600 S1000
+G1 X16.525 Y49.576 F600 S1000
+G1 X16.525 Y35.576 F600 S1000
+G1 X30.525 Y35.576 F600 S1000
+G1 X30.525 Y49.576 F600 S1000
+G1 X16.525 Y49.576 F600 S1000
+G1 X16.525 Y35.576 F600 S1000
+G1 X30.525 Y35.576 F600 S1000
+G1 X30.525 Y49.576 F600 S1000
+G1 X16.525 Y49.576 F600 S1000
+G0 X16.525 Y30.526
+G1 X16.525 Y16.526 F600 S1000
+G1 X30.525 Y16.526 F600 S1000
+G1 X30.525 Y30.526 F600 S1000
+G1 X16.525 Y30.526 F600 S1000
+G1 X16.525 Y16.526 F600 S1000
+G1 X30.525 Y16.526 F600 S1000
+G1 X30.525 Y30.526 F600 S1000
+G1 X16.525 Y30.526 F600 S1000
+G1 X16.525 Y16.526 F600 S1000
+G1 X30.525 Y16.526 F600 S1000
+G1 X30.525 Y30.526 F600 S1000
+G1 X16.525 Y30.526 F600 S1000
+G0 X35.575 Y30.526
+G1 X35.575 Y16.526 F600 S1000
+G1 X49.575 Y16.526 F600 S1000
+G1 X49.575 Y30.526 F600 S1000
+G1 X35.575 Y30.526 F600 S1000
+G1 X35.575 Y16.526 F600 S1000
+G1 X49.575 Y16.526 F600 S1000
+G1 X49.575 Y30.526 F600 S1000
+G1 X35.575 Y30.526 F600 S1000
+G1 X35.575 Y16.526 F600 S1000
+G1 X49.575 Y16.526 F600 S1000
+G1 X49.575 Y30.526 F600 S1000
+G1 X35.575 Y30.526 F600 S1000
+G0 X54.625 Y30.526
+G1 X54.625 Y16.526 F600 S1000
+G1 X68.625 Y16.526 F600 S1000
+G1 X68.625 Y30.526 F600 S1000
+G1 X54.625 Y30.526 F600 S1000
+G1 X54.625 Y16.526 F600 S1000
+G1 X68.625 Y16.526 F600 S1000
+G1 X68.625 Y30.526 F600 S1000
+G1 X54.625 Y30.526 F600 S1000
+G1 X54.625 Y16.526 F600 S1000
+G1 X68.625 Y16.526 F600 S1000
+G1 X68.625 Y30.526 F600 S1000
+G1 X54.625 Y30.526 F600 S1000
+G0 X54.625 Y49.576
+G1 X54.625 Y35.576 F600 S1000
+G1 X68.625 Y35.576 F600 S1000
+G1 X68.625 Y49.576 F600 S1000
+G1 X54.625 Y49.576 F600 S1000
+G1 X54.625 Y35.576 F600 S1000
+G1 X68.625 Y35.576 F600 S1000
+G1 X68.625 Y49.576 F600 S1000
+G1 X54.625 Y49.576 F600 S1000
+G1 X54.625 Y35.576 F600 S1000
+G1 X68.625 Y35.576 F600 S1000
+G1 X68.625 Y49.576 F600 S1000
+G1 X54.625 Y49.576 F600 S1000
+G0 X35.575 Y49.576
+G1 X35.575 Y35.576 F600 S1000
+G1 X49.575 Y35.576 F600 S1000
+G1 X49.575 Y49.576 F600 S1000
+G1 X35.575 Y49.576 F600 S1000
+G1 X35.575 Y35.576 F600 S1000
+G1 X49.575 Y35.576 F600 S1000
+G1 X49.575 Y49.576 F600 S1000
+G1 X35.575 Y49.576 F600 S1000
+G1 X35.575 Y35.576 F600 S1000
+G1 X49.575 Y35.576 F600 S1000
+G1 X49.575 Y49.576 F600 S1000
+G1 X35.575 Y49.576 F600 S1000
+G0 X80.151 Y5.000
+G1 X49.338 Y5.000 F600 S1000
+G1 X45.338 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y26.288 F600 S1000
+G1 X5.000 Y30.288 F600 S0
+G1 X5.000 Y61.101 F600 S1000
+G1 X35.813 Y61.101 F600 S1000
+G1 X39.813 Y61.101 F600 S0
+G1 X80.151 Y61.101 F600 S1000
+G1 X80.151 Y39.813 F600 S1000
+G1 X80.151 Y35.813 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+G1 X49.338 Y5.000 F600 S1000
+G1 X45.338 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y26.288 F600 S1000
+G1 X5.000 Y30.288 F600 S0
+G1 X5.000 Y61.101 F600 S1000
+G1 X35.813 Y61.101 F600 S1000
+G1 X39.813 Y61.101 F600 S0
+G1 X80.151 Y61.101 F600 S1000
+G1 X80.151 Y39.813 F600 S1000
+G1 X80.151 Y35.813 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+G1 X49.338 Y5.000 F600 S1000
+G1 X45.338 Y5.000 F600 S0
+G1 X5.000 Y5.000 F600 S1000
+G1 X5.000 Y26.288 F600 S1000
+G1 X5.000 Y30.288 F600 S0
+G1 X5.000 Y61.101 F600 S1000
+G1 X35.813 Y61.101 F600 S1000
+G1 X39.813 Y61.101 F600 S0
+G1 X80.151 Y61.101 F600 S1000
+G1 X80.151 Y39.813 F600 S1000
+G1 X80.151 Y35.813 F600 S0
+G1 X80.151 Y5.000 F600 S1000
+M5
+G0 X0 Y0
+M2
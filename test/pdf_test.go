@@ -0,0 +1,83 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestPdf(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["","","","","","","","","","","","","","",""],
+			["","","","","","","","","","","","","","",""]
+		],
+		"case": {"case-type":"sandwich"},
+		"pdf":{"page-size":"a4", "overlap":10},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "pdf"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestPdf: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "pdf"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestPdf: failed to Draw the KAD file")
+		return
+	}
+
+	// the 313.75mm wide canvas is tiled over two A4 pages with registration marks
+	cols, rows, landscape := cad.PdfTiles(kad.SWITCHLAYER)
+	if cols != 2 || rows != 1 || landscape {
+		t.Errorf("TestPdf: expected 2x1 portrait pages, got %dx%d landscape %v", cols, rows, landscape)
+	}
+	data, err := os.ReadFile("./output/pdf_switch.pdf")
+	if err != nil {
+		t.Errorf("TestPdf: failed to read the switch layer pdf")
+		return
+	}
+	pdf := string(data)
+	if !strings.HasPrefix(pdf, "%PDF-1.4") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Errorf("TestPdf: expected a complete pdf file")
+	}
+	if n := strings.Count(pdf, "/Type /Page "); n != 2 {
+		t.Errorf("TestPdf: expected 2 pages, got %d", n)
+	}
+	// the second page starts 10mm before the end of the first and both have the marks 185mm into the plate
+	if !strings.Contains(pdf, fmt.Sprintf("%.3f %.3f cm", (10-180)*72/25.4, 287*72/25.4)) {
+		t.Errorf("TestPdf: expected the second page to overlap the first by 10mm")
+	}
+	if !strings.Contains(pdf, "192.000 15.000 m 198.000 15.000 l") || !strings.Contains(pdf, "12.000 15.000 m 18.000 15.000 l") {
+		t.Errorf("TestPdf: expected the registration marks in the middle of the overlap")
+	}
+	if !strings.Contains(pdf, "(50 mm) Tj") {
+		t.Errorf("TestPdf: expected a 50mm scale ruler")
+	}
+	exports := make([]string, 0)
+	for _, e := range cad.Result.Details[kad.SWITCHLAYER].Exports {
+		exports = append(exports, e.Ext)
+	}
+	if !in_strings("pdf", exports) {
+		t.Errorf("TestPdf: expected a pdf export, got %v", exports)
+	}
+}
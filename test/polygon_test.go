@@ -0,0 +1,115 @@
+package kad
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestKerf(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[["",""]],
+		"custom":[{"layers":["switch"], "op":"cut", "polygon":"custom-rectangle",
+			"points":"[0,-13]", "rel_to":"[0,0]", "width":6, "height":2}],
+		"top-padding":10,
+		"left-padding":10,
+		"right-padding":10,
+		"bottom-padding":10,
+		"kerf":0.2
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestKerf: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "kerf"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestKerf: failed to Draw the KAD file")
+		return
+	}
+
+	near := func(a, b float64) bool { return a-b < 0.002 && b-a < 0.002 }
+
+	// the outline grows by half the kerf on every side
+	if !near(cad.Width, 58.3) || !near(cad.Height, 39.25) {
+		t.Errorf("TestKerf: expected a 58.3 x 39.25 case, got %f x %f", cad.Width, cad.Height)
+	}
+
+	// the switch cutout and the custom cutout shrink by half the kerf on every side
+	for _, size := range [][2]float64{{58.3, 39.25}, {13.8, 13.8}, {5.8, 1.8}} {
+		found := false
+		for _, poly := range cad.Layers[kad.SWITCHLAYER].KeepPolys {
+			b := poly.Bounds()
+			if near(b.Xmax-b.Xmin, size[0]) && near(b.Ymax-b.Ymin, size[1]) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("TestKerf: expected a %.2f x %.2f path in the switch layer", size[0], size[1])
+		}
+	}
+}
+
+func TestKerfDimensions(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[["","",""]],
+		"case": {
+			"case-type":"sandwich"
+		},
+		"top-padding":-1,
+		"left-padding":10,
+		"right-padding":10,
+		"bottom-padding":-1,
+		"kerf":0.4
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestKerfDimensions: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "kerf_dimensions"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestKerfDimensions: failed to Draw the KAD file")
+		return
+	}
+
+	// the kerf is only applied to the polygons, so the sizes are the same as without a kerf,
+	// the open and closed layers are the left and right padding laid out 10mm apart
+	for _, layer := range cad.Result.Plates {
+		d := cad.Result.Details[layer]
+		w, h := cad.Width, cad.Height
+		if layer == kad.OPENLAYER || layer == kad.CLOSEDLAYER {
+			w = 10 + 10 + 10
+		}
+		if math.Abs(d.Width-w) > 1e-6 || math.Abs(d.Height-h) > 1e-6 {
+			t.Errorf("TestKerfDimensions: expected the %s layer to be %.2f x %.2f, got %.2f x %.2f", layer, w, h, d.Width, d.Height)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestExportProfiles(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["Esc","Q","W"],
+			["A","S","D"]
+		],
+		"engraving": {
+			"layer-text":true,
+			"serial":"ABC"
+		},
+		"export-profile": {
+			"name":"epilog"
+		},
+		"fabrication":{"mode":"cnc", "tool-diameter":3},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "hpgl"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestExportProfiles: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "export_profile"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestExportProfiles: failed to Draw the KAD file")
+		return
+	}
+
+	// the epilog document is sized in inches with red hairline cuts and blue engraving
+	data, err := os.ReadFile("./output/export_profile_switch.svg")
+	if err != nil {
+		t.Errorf("TestExportProfiles: failed to read the switch layer svg")
+		return
+	}
+	doc := string(data)
+	width := cad.Layers[kad.SWITCHLAYER].Width + 2*cad.DMZ
+	if !strings.Contains(doc, fmt.Sprintf(`width="%.3fin"`, width/25.4)) {
+		t.Errorf("TestExportProfiles: expected the document to be %.3fin wide", width/25.4)
+	}
+	if !strings.Contains(doc, "fill:none;stroke-width:0.025400mm;stroke:#FF0000") || strings.Count(doc, "fill:#0000FF") != 1 {
+		t.Errorf("TestExportProfiles: expected red hairline cuts and blue engraving")
+	}
+	if cad.UOM != "mm" {
+		t.Errorf("TestExportProfiles: expected the profile to leave the design in mm, got %s", cad.UOM)
+	}
+
+	// the hpgl has the cuts with pen 1 then the layer text with pen 2, the cuts follow the design without the cnc tool offset
+	data, err = os.ReadFile("./output/export_profile_switch.hpgl")
+	if err != nil {
+		t.Errorf("TestExportProfiles: failed to read the switch layer hpgl")
+		return
+	}
+	hpgl := string(data)
+	if !strings.HasPrefix(hpgl, "IN;\nSP1;\n") || !strings.HasSuffix(hpgl, "PU;SP0;\n") ||
+		strings.Index(hpgl, "SP2;") < strings.LastIndex(hpgl, "PD") {
+		t.Errorf("TestExportProfiles: expected the cuts with pen 1 before the engraving with pen 2")
+	}
+	if n := strings.Count(hpgl, "PD"); n != len(cad.Layers[kad.SWITCHLAYER].KeepPolys) {
+		t.Errorf("TestExportProfiles: expected %d contours, got %d", len(cad.Layers[kad.SWITCHLAYER].KeepPolys), n)
+	}
+	if !strings.Contains(hpgl, "LBSwitch Layer ABC\x03;") {
+		t.Errorf("TestExportProfiles: expected the layer text as a label")
+	}
+	xmax := 0
+	for _, cmd := range strings.Split(hpgl, ";") {
+		if strings.HasPrefix(strings.TrimSpace(cmd), "PD") {
+			nums := strings.Split(strings.TrimPrefix(strings.TrimSpace(cmd), "PD"), ",")
+			for i := 0; i < len(nums); i += 2 {
+				var x int
+				fmt.Sscan(nums[i], &x)
+				if x > xmax {
+					xmax = x
+				}
+			}
+		}
+	}
+	if math.Abs(float64(xmax)-(width-cad.DMZ)*40) > 1 {
+		t.Errorf("TestExportProfiles: expected the plate to end at %.0f plotter units, got %d", (width-cad.DMZ)*40, xmax)
+	}
+}
+
+func TestUnknownExportProfile(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[["",""]],
+		"export-profile":{"name":"laserzone", "units":"in", "cut-color":"#FF0000"},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestUnknownExportProfile: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "export_profile_unknown"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestUnknownExportProfile: failed to Draw the KAD file")
+		return
+	}
+
+	// an unknown profile is ignored, so the document keeps the default size and style
+	data, err := os.ReadFile("./output/export_profile_unknown_switch.svg")
+	if err != nil {
+		t.Fatalf("TestUnknownExportProfile: failed to read the switch layer svg")
+	}
+	doc := string(data)
+	width := cad.Layers[kad.SWITCHLAYER].Width + 2*cad.DMZ
+	if !strings.Contains(doc, fmt.Sprintf(`width="%.3fmm"`, width)) {
+		t.Errorf("TestUnknownExportProfile: expected the document to be %.3fmm wide", width)
+	}
+	if !strings.Contains(doc, "stroke-width:0.050000mm;stroke:black") || strings.Contains(doc, "#FF0000") {
+		t.Errorf("TestUnknownExportProfile: expected the default line style")
+	}
+}
+
+func TestExportProfileStyle(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[["",""]],
+		"export-profile":{"name":"ponoko", "units":"furlong"},
+		"SvgStyle":"fill:#EEEEEE",
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestExportProfileStyle: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "export_profile_style"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestExportProfileStyle: failed to Draw the KAD file")
+		return
+	}
+
+	// the unknown units are dropped and the given style is kept under the colors of the profile
+	if cad.Profile.Units != "" {
+		t.Errorf("TestExportProfileStyle: expected the unknown units to be dropped, got %s", cad.Profile.Units)
+	}
+	data, err := os.ReadFile("./output/export_profile_style_switch.svg")
+	if err != nil {
+		t.Fatalf("TestExportProfileStyle: failed to read the switch layer svg")
+	}
+	doc := string(data)
+	width := cad.Layers[kad.SWITCHLAYER].Width + 2*cad.DMZ
+	if !strings.Contains(doc, fmt.Sprintf(`width="%.3fmm"`, width)) {
+		t.Errorf("TestExportProfileStyle: expected the document to be %.3fmm wide", width)
+	}
+	if !strings.Contains(doc, "fill:#EEEEEE;stroke-width:0.010000mm;stroke:#0000FF") {
+		t.Errorf("TestExportProfileStyle: expected the given fill with the ponoko line style")
+	}
+}
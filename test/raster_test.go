@@ -0,0 +1,103 @@
+package kad
+
+import (
+	"encoding/json"
+	"image/png"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestPreview(t *testing.T) {
+	// the coverage of the pixels adds up to the area of the paths, with the holes removed
+	square := kad.Path{{X: 0.25, Y: 0.25}, {X: 10.25, Y: 0.25}, {X: 10.25, Y: 10.25}, {X: 0.25, Y: 10.25}}
+	hole := kad.Path{{X: 3, Y: 3}, {X: 7, Y: 3}, {X: 7, Y: 7}, {X: 3, Y: 7}}
+	triangle := kad.Path{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+	cases := []struct {
+		paths []kad.Path
+		area  float64
+	}{
+		{[]kad.Path{square}, 100},
+		{[]kad.Path{square, hole}, 84},
+		{[]kad.Path{triangle}, 50},
+	}
+	for _, c := range cases {
+		total := 0.0
+		for _, v := range kad.RasterizePaths(c.paths, 1, 12, 12) {
+			total += v
+		}
+		if math.Abs(total-c.area) > 0.5 {
+			t.Errorf("TestPreview: expected a coverage of %.0f, got %.2f", c.area, total)
+		}
+	}
+
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["","",""],
+			["","",""]
+		],
+		"case": {"case-type":"sandwich"},
+		"preview":{"dpi":50.8, "colors":{"switch":"#ff0000"}},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "png"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestPreview: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "preview"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestPreview: failed to Draw the KAD file")
+		return
+	}
+
+	// at 2 pixels per mm, the switch plate is red on white
+	file, err := os.Open("./output/preview_switch.png")
+	if err != nil {
+		t.Errorf("TestPreview: failed to open the switch layer png")
+		return
+	}
+	defer file.Close()
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Errorf("TestPreview: failed to decode the switch layer png")
+		return
+	}
+	w := int(math.Ceil((cad.Layers[kad.SWITCHLAYER].Width + 2*cad.DMZ) * 2))
+	if img.Bounds().Dx() != w {
+		t.Errorf("TestPreview: expected the png to be %d pixels wide, got %d", w, img.Bounds().Dx())
+	}
+	if r, g, b, _ := img.At(2, 2).RGBA(); r>>8 != 255 || g>>8 != 255 || b>>8 != 255 {
+		t.Errorf("TestPreview: expected the margin to be white")
+	}
+	if r, g, b, _ := img.At(20, 20).RGBA(); r>>8 != 255 || g>>8 != 0 || b>>8 != 0 {
+		t.Errorf("TestPreview: expected the plate to be red")
+	}
+	if len(cad.Result.Previews) != 1 {
+		t.Errorf("TestPreview: expected a stacked preview, got %v", cad.Result.Previews)
+	}
+
+	// the numbered wrist rest layers have the color of the wrist rest
+	wrist, _ := kad.ParseHexColor(kad.PREVIEW_COLORS[kad.WRISTLAYER])
+	if c := cad.PreviewColor(kad.WRISTLAYER + "_2"); c != wrist {
+		t.Errorf("TestPreview: expected the second wrist rest layer to be %v, got %v", wrist, c)
+	}
+}
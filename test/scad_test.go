@@ -0,0 +1,84 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestScad(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["Esc","Q","W"],
+			["A","S","D"]
+		],
+		"case": {"case-type":"sandwich"},
+		"model":{"thickness":{"switch":1.5}},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "scad"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestScad: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "scad"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestScad: failed to Draw the KAD file")
+		return
+	}
+
+	// each layer is a module with a path for each of its polygons
+	data, err := os.ReadFile("./output/scad_switch.scad")
+	if err != nil {
+		t.Errorf("TestScad: failed to read the switch layer scad file")
+		return
+	}
+	layer := string(data)
+	if !strings.Contains(layer, "module switch_layer(thickness = 1.500) {") || !strings.Contains(layer, "switch_layer(switch_thickness);") {
+		t.Errorf("TestScad: expected a switch_layer module using the thickness")
+	}
+	paths := layer[strings.Index(layer, "paths = ["):]
+	if n := strings.Count(paths, "\t\t\t["); n != len(cad.Layers[kad.SWITCHLAYER].KeepPolys) {
+		t.Errorf("TestScad: expected %d paths, got %d", len(cad.Layers[kad.SWITCHLAYER].KeepPolys), n)
+	}
+
+	// the assembly stacks the layers and names the switch centers
+	data, err = os.ReadFile("./output/scad_case.scad")
+	if err != nil {
+		t.Errorf("TestScad: failed to read the case scad file")
+		return
+	}
+	assembly := string(data)
+	height := cad.Layers[kad.SWITCHLAYER].Height + 2*cad.DMZ
+	expected := []string{
+		"use <scad_bottom.scad>",
+		"use <scad_switch.scad>",
+		fmt.Sprintf("key_1_1 = [23.5250, %.4f]; // Esc", height-23.525),
+		fmt.Sprintf("key_2_3 = [61.6250, %.4f]; // D", height-42.575),
+		"translate([0, 0, bottom_thickness + closed_thickness + open_thickness]) switch_layer(switch_thickness);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(assembly, e) {
+			t.Errorf("TestScad: expected the assembly to contain %q", e)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package kad
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestSegmentation(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["","","","","","","","","","","","","","",""],
+			["","","","","","","","","","","","","","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":6,
+			"mount-holes-size":3,
+			"mount-holes-edge":6,
+			"usb-width":0
+		},
+		"segmentation":{"max-width":120, "joint-size":4},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3,
+		"kerf":0.2
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestSegmentation: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "segmentation"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestSegmentation: failed to Draw the KAD file")
+		return
+	}
+
+	// a 303.95mm wide plate is split into 3 pieces, without cutting through any of the 30 switch openings
+	// (there is no usb opening, since the middle hole at the top would run into it)
+	if len(cad.Result.Warnings) != 0 {
+		t.Errorf("TestSegmentation: unexpected warnings %v", cad.Result.Warnings)
+	}
+	openings := 0
+	for i := 1; i <= 3; i++ {
+		layer := fmt.Sprintf("%s_%d", kad.SWITCHLAYER, i)
+		if !in_strings(layer, cad.Result.Plates) {
+			t.Errorf("TestSegmentation: expected the %s plate", layer)
+			continue
+		}
+		if w := cad.Result.Details[layer].Width; w > 120 {
+			t.Errorf("TestSegmentation: expected %s to be at most 120mm wide, got %.2f", layer, w)
+		}
+		for _, path := range cad.Layers[layer].KeepPolys {
+			if b := path.Bounds(); b.Xmax-b.Xmin > 13.7 && b.Xmax-b.Xmin < 13.9 && b.Ymax-b.Ymin > 13.7 && b.Ymax-b.Ymin < 13.9 {
+				openings++
+			}
+		}
+	}
+	if openings != 30 {
+		t.Errorf("TestSegmentation: expected 30 whole switch openings across the pieces, got %d", openings)
+	}
+	if in_strings(kad.SWITCHLAYER, cad.Result.Plates) {
+		t.Errorf("TestSegmentation: expected the switch plate to be replaced by its pieces")
+	}
+	if _, ok := cad.Result.Details[kad.SWITCHLAYER]; ok {
+		t.Errorf("TestSegmentation: expected the details of the switch plate to be replaced by its pieces")
+	}
+}
+
+func TestSegmentationCnc(t *testing.T) {
+	json_str := `{
+		"switch-type":1,
+		"layout":[
+			["","","","","","",""],
+			["","","","","","",""]
+		],
+		"fabrication":{"mode":"cnc", "tool-diameter":2},
+		"segmentation":{"max-width":80, "joint-size":4},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg", "png"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestSegmentationCnc: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "segmentation_cnc"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestSegmentationCnc: failed to Draw the KAD file")
+		return
+	}
+
+	// the split layers are left out of the stacked preview with a warning
+	split := make([]string, 0)
+	for _, w := range cad.Result.Warnings {
+		if w.Code == kad.SEGMENT_STACK {
+			split = append(split, w.Layer)
+		}
+	}
+	if len(split) == 0 || !in_strings(kad.SWITCHLAYER, split) {
+		t.Errorf("TestSegmentationCnc: expected a warning for the split switch layer, got %v", split)
+	}
+
+	// the inside corners of the seams and the joints are relieved for the tool like the rest of the plate
+	pieces := 0
+	for _, layer := range cad.Result.Plates {
+		if !strings.HasPrefix(layer, kad.SWITCHLAYER+"_") {
+			continue
+		}
+		pieces++
+		polys := cad.Layers[layer].KeepPolys
+		sign := kad.OuterSign(polys)
+		for _, path := range polys {
+			if c := path.ReliefCenters(1, sign, false); len(c) > 0 {
+				t.Errorf("TestSegmentationCnc: expected every inside corner of %s to be relieved, found %v", layer, c)
+				break
+			}
+		}
+	}
+	if pieces < 2 {
+		t.Errorf("TestSegmentationCnc: expected the switch plate to be split, got %v", cad.Result.Plates)
+	}
+}
+
+func TestSegmentationWarnings(t *testing.T) {
+	cases := []struct {
+		hash    string
+		segment string
+		code    string
+	}{
+		{"segmentation_unsafe", `{"max-width":20, "joint-size":4}`, kad.SEGMENT_UNSAFE},       // the pieces are narrower than a key
+		{"segmentation_no_joint", `{"max-width":130, "joint-size":20}`, kad.SEGMENT_NO_JOINT}, // the joints are larger than the web
+	}
+	for _, c := range cases {
+		json_str := `{
+			"switch-type":1,
+			"layout":[
+				["","","","","","",""],
+				["","","","","","",""]
+			],
+			"segmentation":` + c.segment + `,
+			"top-padding":9,
+			"left-padding":9,
+			"right-padding":9,
+			"bottom-padding":9
+		}`
+
+		cad := kad.New()
+		cad.Result.Formats = []string{"svg"}
+
+		decoder := json.NewDecoder(strings.NewReader(json_str))
+		err := decoder.Decode(cad)
+		if err != nil {
+			t.Errorf("TestSegmentationWarnings: failed to parse json data into KAD file")
+			continue
+		}
+
+		cad.Hash = c.hash
+		cad.FileStore = kad.STORE_LOCAL
+		cad.FileDirectory = "./output/"
+		cad.FileServePath = "/test/output/"
+
+		err = cad.Draw()
+		if err != nil {
+			t.Errorf("TestSegmentationWarnings: failed to Draw the KAD file")
+			continue
+		}
+
+		codes := make([]string, 0)
+		for _, w := range cad.Result.Warnings {
+			codes = append(codes, w.Code)
+		}
+		if !in_strings(c.code, codes) {
+			t.Errorf("TestSegmentationWarnings: %s expected a %s warning, got %v", c.hash, c.code, codes)
+		}
+	}
+}
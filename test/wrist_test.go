@@ -0,0 +1,67 @@
+package kad
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swill/kad"
+)
+
+func TestWristRest(t *testing.T) {
+	json_str := `{
+		"layout":[
+			["","","","","","","",""],
+			["","","","","","","",""]
+		],
+		"case": {
+			"case-type":"sandwich",
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"mount-holes-edge":6
+		},
+		"wrist-rest": {
+			"depth":70,
+			"layers":3,
+			"mount-holes-num":4,
+			"mount-holes-size":3,
+			"magnets-num":2,
+			"magnets-size":6
+		},
+		"top-padding":9,
+		"left-padding":9,
+		"right-padding":9,
+		"bottom-padding":9,
+		"fillet":3
+	}`
+
+	cad := kad.New()
+	cad.Result.Formats = []string{"svg"}
+
+	decoder := json.NewDecoder(strings.NewReader(json_str))
+	err := decoder.Decode(cad)
+	if err != nil {
+		t.Errorf("TestWristRest: failed to parse json data into KAD file")
+		return
+	}
+
+	cad.Hash = "wrist_rest"
+	cad.FileStore = kad.STORE_LOCAL
+	cad.FileDirectory = "./output/"
+	cad.FileServePath = "/test/output/"
+
+	err = cad.Draw()
+	if err != nil {
+		t.Errorf("TestWristRest: failed to Draw the KAD file")
+		return
+	}
+	for _, layer := range []string{"wrist_1", "wrist_2", "wrist_3"} {
+		if !strings.Contains(strings.Join(cad.Result.Plates, ","), layer) {
+			t.Errorf("TestWristRest: missing plate %s in %v", layer, cad.Result.Plates)
+			continue
+		}
+		if cad.Result.Details[layer].Height != 70 || cad.Result.Details[layer].Area <= 0 {
+			t.Errorf("TestWristRest: unexpected details for %s: %#v", layer, cad.Result.Details[layer])
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package kad
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	clipper "github.com/swill/go.clipper"
+)
+
+const (
+	WRISTLAYER      = "wrist"
+	WRISTLAYER_NAME = "Wrist Rest Layer"
+)
+
+type WristRest struct {
+	Depth          float64 `json:"depth"`            // depth of the wrist rest, no wrist rest is drawn when zero
+	Fillet         float64 `json:"fillet"`           // radius of the corners, defaults to the case fillet
+	Layers         int     `json:"layers"`           // number of stacked layers
+	Holes          int     `json:"mount-holes-num"`  // number of holes through all the layers
+	HoleDiameter   float64 `json:"mount-holes-size"` // diameter of the holes through all the layers
+	HoleEdge       float64 `json:"mount-holes-edge"` // width of the edge where the holes are placed
+	Magnets        int     `json:"magnets-num"`      // number of magnets along the edge facing the case
+	MagnetDiameter float64 `json:"magnets-size"`     // diameter of the magnets
+}
+
+// Draw the layers of a wrist rest which matches the width of the case.
+func (k *KAD) DrawWristRest() {
+	wr := k.WristRest
+	if wr.Depth <= 0 {
+		return
+	}
+	if wr.Layers < 1 {
+		wr.Layers = 1
+	}
+	fillet := k.Fillet
+	if wr.Fillet > 0 {
+		fillet = wr.Fillet
+	}
+	corner_segments := 20
+	if fillet == 0 {
+		corner_segments = 0 // square corner
+	}
+	// the kerf is added around the outline when the layer is clipped
+	width, height := k.Width-2*k.Kerf, wr.Depth
+	center := Point{k.DMZ + k.Kerf + width/2, k.DMZ + k.Kerf + height/2}
+	fillet = math.Min(fillet, math.Min(width, height)/2)
+	arcs := &ArcIndex{Kerf: k.Kerf} // the wrist rest is not drawn in the frame of the case
+
+	// mount holes are spread around the edge of the wrist rest
+	holes := make(Path, 0)
+	if wr.Holes > 0 && wr.HoleDiameter > 0 {
+		edge := wr.HoleEdge
+		if edge <= 0 {
+			edge = 2 * wr.HoleDiameter
+		}
+		outline := RoundRectanglePolygon(center.X, center.Y, width, height, fillet, corner_segments)
+		ring := OffsetPaths([]Path{outline}, -edge/2, clipper.JtMiter)
+		holes = SpreadPoints(RingPoints(ring, HOLE_STEP), wr.Holes,
+			SpreadPoints(RingCorners(ring, CORNER_ANGLE, CORNER_SPAN), wr.Holes, nil))
+	}
+
+	// magnets are evenly spaced along the edge which faces the case
+	magnets := make(Path, 0)
+	for i := 1; i <= wr.Magnets && wr.MagnetDiameter > 0; i++ {
+		magnets = append(magnets, Point{
+			center.X - width/2 + width*float64(i)/float64(wr.Magnets+1), center.Y - height/2 + wr.MagnetDiameter})
+	}
+
+	for i := 1; i <= wr.Layers; i++ {
+		layer, name := WRISTLAYER, WRISTLAYER_NAME
+		if wr.Layers > 1 {
+			layer, name = fmt.Sprintf("%s_%d", WRISTLAYER, i), fmt.Sprintf("%s %d", WRISTLAYER_NAME, i)
+		}
+		k.Result.Plates = append(k.Result.Plates, layer)
+		k.Result.Details[layer] = &ResultDetails{
+			Name:   name,
+			Width:  width + 2*k.Kerf,
+			Height: height + 2*k.Kerf,
+		}
+		k.Layers[layer] = &Layer{
+			KeepPolys: []Path{arcs.RoundRectangle(center.X, center.Y, width, height, fillet, corner_segments)},
+			Arcs:      arcs,
+			Width:     width + 2*k.Kerf,
+			Height:    height + 2*k.Kerf,
+		}
+		for _, pt := range holes {
+			k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys,
+				arcs.Circle(pt.X, pt.Y, wr.HoleDiameter/2, 5))
+		}
+		if i > 1 || wr.Layers == 1 { // keep the magnets hidden under the top layer
+			for _, pt := range magnets {
+				k.Layers[layer].CutPolys = append(k.Layers[layer].CutPolys,
+					arcs.Circle(pt.X, pt.Y, wr.MagnetDiameter/2, 5))
+			}
+		}
+		if !k.ClipLayer(layer) {
+			log.Printf("ERROR Context (wrist rest):\n%#v", k.WristRest)
+		}
+	}
+}